@@ -0,0 +1,160 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package acronymcase provides an analyzer that checks initialisms in identifiers are consistently cased.
+package acronymcase
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_acronym_case"
+	doc          = `checks initialisms in exported identifiers are consistently cased
+
+Go style writes initialisms such as ID, URL and HTTP in a single case,
+so exported identifiers should use UserID rather than UserId and
+HTTPServer rather than HttpServer.
+
+Bad:
+    type HttpServer struct {
+        BaseUrl string
+    }
+
+Good:
+    type HTTPServer struct {
+        BaseURL string
+    }`
+)
+
+// NewAnalyzer creates a new acronym case analyzer.
+// The initialisms are matched case-insensitively against the words of each identifier.
+func NewAnalyzer(initialisms []string) *analysis.Analyzer {
+	r := &runner{
+		initialisms: make(map[string]bool, len(initialisms)),
+	}
+	for _, initialism := range initialisms {
+		r.initialisms[strings.ToUpper(initialism)] = true
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	initialisms map[string]bool
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.FuncDecl:
+				r.checkIdent(pass, node.Name)
+			case *ast.TypeSpec:
+				r.checkIdent(pass, node.Name)
+			case *ast.StructType:
+				for _, field := range node.Fields.List {
+					for _, name := range field.Names {
+						r.checkIdent(pass, name)
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+func (r *runner) checkIdent(pass *analysis.Pass, ident *ast.Ident) {
+	if !token.IsExported(ident.Name) {
+		return
+	}
+
+	if fixed := r.fixIdentifier(ident.Name); fixed != ident.Name {
+		pass.Reportf(ident.Pos(), "identifier %q should be %q", ident.Name, fixed)
+	}
+}
+
+// fixIdentifier returns the identifier with every initialism uniformly cased.
+func (r *runner) fixIdentifier(name string) string {
+	words := splitWords(name)
+	for i, word := range words {
+		words[i] = r.fixWord(word)
+	}
+
+	return strings.Join(words, "")
+}
+
+// fixWord returns the correctly cased form of a word if it is an initialism,
+// or an initialism followed by a plural "s", otherwise the word unchanged.
+func (r *runner) fixWord(word string) string {
+	if upper := strings.ToUpper(word); r.initialisms[upper] {
+		return upper
+	}
+
+	if base, ok := strings.CutSuffix(word, "s"); ok && base != "" {
+		if upper := strings.ToUpper(base); r.initialisms[upper] {
+			return upper + "s"
+		}
+	}
+
+	return word
+}
+
+// splitWords splits an identifier into its CamelCase words. Runs of upper
+// case letters form a single word, except that the final upper case letter
+// starts a new word when followed by a lower case letter, so "HTTPServer"
+// splits into "HTTP" and "Server". Digits and underscores form their own
+// words. Joining the words reproduces the identifier.
+func splitWords(name string) []string {
+	runes := []rune(name)
+
+	var words []string
+
+	start := 0
+
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+
+		boundary := false
+
+		switch {
+		case cur == '_' || prev == '_':
+			boundary = true
+		case unicode.IsDigit(cur) != unicode.IsDigit(prev):
+			boundary = true
+		case unicode.IsUpper(cur) && unicode.IsLower(prev):
+			boundary = true
+		case unicode.IsUpper(cur) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			boundary = true
+		}
+
+		if boundary {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	return append(words, string(runes[start:]))
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package acronymcase
+
+// Bad: Http should be HTTP.
+type HttpServer struct { // want `identifier "HttpServer" should be "HTTPServer"`
+	// Bad: Url should be URL.
+	BaseUrl string // want `identifier "BaseUrl" should be "BaseURL"`
+
+	// Bad: Id should be ID.
+	UserId int // want `identifier "UserId" should be "UserID"`
+
+	// Bad: plural initialism.
+	PeerIds []int // want `identifier "PeerIds" should be "PeerIDs"`
+
+	// Good: initialisms are uniformly cased.
+	UserID     int
+	PeerIDs    []int
+	JSONConfig string
+
+	// Good: unexported fields are not checked.
+	requestId int
+}
+
+// Bad: several initialisms in one identifier.
+func (s *HttpServer) ServeJsonApi() {} // want `identifier "ServeJsonApi" should be "ServeJSONAPI"`
+
+// Bad: initialism before a digit.
+func NewHttp2Server() {} // want `identifier "NewHttp2Server" should be "NewHTTP2Server"`
+
+// Good: HTTP followed by a word.
+type HTTPClient struct{}
+
+// Good: words that merely contain an initialism.
+type Identity struct {
+	Urlencoded bool
+	Apiary     string
+}
+
+// Good: unexported identifiers are not checked.
+func parseUrl() {}
+
+// Good: type name is uniformly cased.
+type URLResolver interface {
+	ResolveURL() string
+}
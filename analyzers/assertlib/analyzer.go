@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package assertlib provides an analyzer that detects manual comparisons in tests.
+// Tests should use the project's assertion library rather than comparing values by hand.
+package assertlib
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_assert_lib"
+	doc          = `detects manual comparisons followed by t.Errorf or t.Fatalf in tests
+
+When a project standardises on an assertion library such as testify's
+require, hand-written comparisons make tests inconsistent and produce less
+helpful failure messages. An if statement in a _test.go file whose condition
+compares two values with == or != and whose body calls Errorf or Fatalf on a
+*testing.T, *testing.B or testing.TB is reported.
+
+Comparisons with nil, such as err != nil, are not reported.
+
+Bad:
+    if got != want {
+        t.Errorf("got %d, want %d", got, want)
+    }
+
+Good:
+    require.Equal(t, want, got)`
+)
+
+// NewAnalyzer creates a new assert-lib analyzer.
+// The libName is the package name of the assertion library, such as "require",
+// used in diagnostics.
+func NewAnalyzer(libName string) *analysis.Analyzer {
+	r := &runner{
+		libName: libName,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	libName string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Package).Filename
+		if !strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			ifStmt, ok := n.(*ast.IfStmt)
+			if !ok || ifStmt.Else != nil {
+				return true
+			}
+
+			if isManualComparison(pass, ifStmt) {
+				pass.Reportf(ifStmt.Pos(), "use %s.Equal instead of manual comparison", r.libName)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isManualComparison checks if an if statement compares two values with == or
+// != and reports a failure with Errorf or Fatalf in its body.
+func isManualComparison(pass *analysis.Pass, ifStmt *ast.IfStmt) bool {
+	cond, ok := ast.Unparen(ifStmt.Cond).(*ast.BinaryExpr)
+	if !ok || (cond.Op != token.EQL && cond.Op != token.NEQ) {
+		return false
+	}
+
+	if isNil(pass, cond.X) || isNil(pass, cond.Y) {
+		return false
+	}
+
+	for _, stmt := range ifStmt.Body.List {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+
+		if call, ok := exprStmt.X.(*ast.CallExpr); ok && isFailureCall(pass, call) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isNil checks if an expression is the predeclared nil.
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+
+	return ok && tv.IsNil()
+}
+
+// isFailureCall checks if a call is Errorf or Fatalf on a testing value.
+func isFailureCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || (sel.Sel.Name != "Errorf" && sel.Sel.Name != "Fatalf") {
+		return false
+	}
+
+	return isTestingType(pass.TypesInfo.TypeOf(sel.X))
+}
+
+// isTestingType checks if a type is *testing.T, *testing.B or testing.TB.
+func isTestingType(t types.Type) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "testing" {
+		return false
+	}
+
+	switch named.Obj().Name() {
+	case "T", "B", "TB":
+		return true
+	default:
+		return false
+	}
+}
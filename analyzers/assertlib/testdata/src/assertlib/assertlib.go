@@ -0,0 +1,18 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package assertlib
+
+import "testing"
+
+// Add returns the sum of a and b.
+func Add(a int, b int) int {
+	return a + b
+}
+
+// CheckAdd is a helper outside a test file, which is not checked.
+func CheckAdd(t *testing.T, want int) {
+	if got := Add(1, 2); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package assertlib
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	got := Add(1, 2)
+	if got != 3 { // want `use require.Equal instead of manual comparison`
+		t.Errorf("got %d, want 3", got)
+	}
+
+	if want := 3; want == got { // want `use require.Equal instead of manual comparison`
+		t.Fatalf("unexpected %d", got)
+	}
+
+	if got != 4 { // want `use require.Equal instead of manual comparison`
+		t.Log("checking")
+		t.Fatalf("got %d, want 4", got)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	err := errors.New("failed")
+
+	// Nil checks are not reported.
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+}
+
+func TestOther(t *testing.T) {
+	got := Add(1, 2)
+
+	// Other comparisons are not reported.
+	if got > 3 {
+		t.Errorf("got %d, want at most 3", got)
+	}
+
+	// Bodies that do not fail the test are not reported.
+	if got != 3 {
+		t.Logf("got %d", got)
+	}
+
+	// Statements with an else branch are not reported.
+	if got != 3 {
+		t.Errorf("got %d", got)
+	} else {
+		t.Log("ok")
+	}
+}
+
+func BenchmarkAdd(b *testing.B) {
+	if got := Add(1, 2); got != 3 { // want `use require.Equal instead of manual comparison`
+		b.Fatalf("got %d", got)
+	}
+}
+
+func check(tb testing.TB, got int, want int) {
+	if got != want { // want `use require.Equal instead of manual comparison`
+		tb.Errorf("got %d, want %d", got, want)
+	}
+}
@@ -15,9 +15,13 @@
 package capitalcomment
 
 import (
+	"fmt"
 	"go/ast"
+	"go/token"
+	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -33,6 +37,31 @@ Exceptions are made for:
 - URLs
 - Comments that start with punctuation
 
+The mode selects the comments checked: the first line of each comment
+group (first-line, the default), the first line of each sentence or
+paragraph in a group (all-lines), or the first line of doc comments only
+(doc-only). In all-lines mode block comments are checked line by line, and
+bulleted or numbered list items, with the lines wrapped from them, are
+skipped.
+
+Optionally, doc comments on exported declarations must also end with a
+period (or other sentence-ending punctuation).
+
+Optionally, a comment whose first word names a field, parameter or
+variable declared in the enclosing declaration is treated as an
+identifier reference and not reported.
+
+Optionally, a glossary lists terms such as ctx or zerolog that may start a
+comment in lowercase.
+
+Optionally, comments whose first word is in capitals, such as
+"DO NOT CHANGE", are reported unless the word is a known acronym or a note
+marker such as "NOTE:".
+
+Optionally, comments consisting of a file path, such as config/app.yaml,
+or starting with a command-line flag, such as --output=json, are not
+checked.
+
 Bad:
     // this is a comment
 
@@ -41,20 +70,172 @@ Good:
     // someVariable is used for...`
 )
 
-// Analyzer is the capital comment analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Mode selects the comments checked by the analyzer.
+type Mode uint64
+
+const (
+	// ModeUnknown is an unrecognised mode.
+	ModeUnknown Mode = iota
+	// ModeFirstLine checks the first line of each comment group.
+	ModeFirstLine
+	// ModeAllLines checks each line of a comment group that starts a sentence or paragraph.
+	ModeAllLines
+	// ModeDocOnly checks the first line of doc comments only.
+	ModeDocOnly
+)
+
+var modeStrings = [...]string{
+	"unknown",
+	"first-line",
+	"all-lines",
+	"doc-only",
+}
+
+// String returns the configuration name of the mode.
+func (m Mode) String() string {
+	if int(m) >= len(modeStrings) {
+		return modeStrings[ModeUnknown]
+	}
+
+	return modeStrings[m]
+}
+
+// ParseMode parses a mode from its configuration name.
+func ParseMode(name string) (Mode, error) {
+	for i, str := range modeStrings {
+		if i != int(ModeUnknown) && str == name {
+			return Mode(i), nil
+		}
+	}
+
+	return ModeUnknown, fmt.Errorf("unknown capital comment mode %q; must be one of first-line, all-lines or doc-only", name)
+}
+
+// Analyzer is the capital comment analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the capital comment analyzer.
+type Option func(*runner)
+
+// WithMode sets the comments checked. The default is ModeFirstLine.
+func WithMode(mode Mode) Option {
+	return func(r *runner) {
+		r.mode = mode
+	}
+}
+
+// WithRequirePeriod sets whether doc comments on exported declarations must
+// end with sentence-ending punctuation.
+func WithRequirePeriod(requirePeriod bool) Option {
+	return func(r *runner) {
+		r.requirePeriod = requirePeriod
+	}
+}
+
+// WithCheckScope sets whether the first word of a comment is looked up
+// among the names declared in the enclosing declaration, skipping comments
+// that start with one of them.
+func WithCheckScope(checkScope bool) Option {
+	return func(r *runner) {
+		r.checkScope = checkScope
+	}
+}
+
+// WithLowercaseGlossary sets terms, such as "ctx" or "zerolog", that may
+// start a comment in lowercase. Terms are matched case-sensitively against
+// the first word of the comment.
+func WithLowercaseGlossary(terms []string) Option {
+	return func(r *runner) {
+		r.glossary = make(map[string]bool, len(terms))
+		for _, term := range terms {
+			r.glossary[term] = true
+		}
+	}
+}
+
+// WithNoAllCaps sets whether comments whose first word is written entirely
+// in capitals are reported.
+func WithNoAllCaps(noAllCaps bool) Option {
+	return func(r *runner) {
+		r.noAllCaps = noAllCaps
+	}
+}
+
+// WithAcronyms sets the acronyms, such as "HTTP" or "ID", that may start a
+// comment in capitals when WithNoAllCaps is set.
+func WithAcronyms(acronyms []string) Option {
+	return func(r *runner) {
+		r.acronyms = make(map[string]bool, len(acronyms))
+		for _, acronym := range acronyms {
+			r.acronyms[strings.ToUpper(acronym)] = true
+		}
+	}
+}
+
+// WithSkipPathsAndFlags sets whether comments that consist of a file path
+// or start with a command-line flag are skipped.
+func WithSkipPathsAndFlags(skipPathsAndFlags bool) Option {
+	return func(r *runner) {
+		r.skipPathsAndFlags = skipPathsAndFlags
+	}
 }
 
-func run(pass *analysis.Pass) (any, error) {
+// NewAnalyzer creates a new capital comment analyzer with the given options.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{
+		mode: ModeFirstLine,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	mode              Mode
+	requirePeriod     bool
+	checkScope        bool
+	glossary          map[string]bool
+	noAllCaps         bool
+	acronyms          map[string]bool
+	skipPathsAndFlags bool
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
 	for _, file := range pass.Files {
+		var docs map[*ast.CommentGroup]bool
+		if r.mode == ModeDocOnly {
+			docs = docComments(file)
+		}
+
 		for _, cg := range file.Comments {
-			// Only check the first comment in each group.
-			// Subsequent comments are continuations and may legitimately start lowercase.
-			if len(cg.List) > 0 {
-				checkComment(pass, cg.List[0])
+			if len(cg.List) == 0 || (docs != nil && !docs[cg]) {
+				continue
+			}
+
+			enclosing := enclosingDecl(file, cg)
+
+			for _, line := range r.linesToCheck(cg) {
+				if r.skipPathsAndFlags && isPathOrFlag(line.content()) {
+					continue
+				}
+
+				r.checkComment(pass, line, enclosing)
+
+				if r.noAllCaps {
+					checkAllCaps(pass, line, r.acronyms)
+				}
+			}
+		}
+
+		if r.requirePeriod {
+			for _, cg := range exportedDocComments(file) {
+				checkPeriod(pass, cg)
 			}
 		}
 	}
@@ -62,15 +243,150 @@ func run(pass *analysis.Pass) (any, error) {
 	return nil, nil
 }
 
-func checkComment(pass *analysis.Pass, c *ast.Comment) {
-	text := c.Text
+// commentLine is a line of a comment: a whole line comment, or a line of a
+// block comment.
+type commentLine struct {
+	text      string    // The line without its comment markers.
+	pos       token.Pos // The position of the start of text.
+	directive bool
+}
+
+// content returns the text of a line without surrounding white space.
+func (l commentLine) content() string {
+	return strings.TrimSpace(l.text)
+}
+
+// contentPos returns the position of the first content character of a line.
+func (l commentLine) contentPos() token.Pos {
+	trimmed := strings.TrimLeftFunc(l.text, unicode.IsSpace)
+
+	return l.pos + token.Pos(len(l.text)-len(trimmed))
+}
+
+// wholeComment returns a comment as a single line, so that a block comment
+// is checked from its first content character.
+func wholeComment(c *ast.Comment) commentLine {
+	return commentLine{
+		text:      strings.TrimSuffix(c.Text[2:], "*/"), // Both "//" and "/*" are two bytes.
+		pos:       c.Pos() + 2,
+		directive: isDirective(c.Text),
+	}
+}
+
+// commentLines splits a comment into lines. A line comment is one line.
+func commentLines(c *ast.Comment) []commentLine {
+	if strings.HasPrefix(c.Text, "//") {
+		return []commentLine{wholeComment(c)}
+	}
 
-	// Remove comment prefix.
-	text = strings.TrimPrefix(text, "//")
-	text = strings.TrimPrefix(text, "/*")
-	text = strings.TrimSuffix(text, "*/")
-	text = strings.TrimSpace(text)
+	var lines []commentLine
+
+	pos := c.Pos() + 2
+	for text := range strings.SplitSeq(strings.TrimSuffix(c.Text[2:], "*/"), "\n") {
+		lines = append(lines, commentLine{text: text, pos: pos})
+		pos += token.Pos(len(text) + 1)
+	}
+
+	return lines
+}
+
+// linesToCheck returns the lines of a group that should start with a
+// capital letter.
+func (r *runner) linesToCheck(cg *ast.CommentGroup) []commentLine {
+	// Subsequent lines are usually continuations and may legitimately
+	// start lowercase, so other modes only check the first comment.
+	if r.mode != ModeAllLines {
+		return []commentLine{wholeComment(cg.List[0])}
+	}
+
+	var lines []commentLine
+	for _, c := range cg.List {
+		lines = append(lines, commentLines(c)...)
+	}
+
+	checked := []commentLine{lines[0]}
+
+	// A list item runs until the next blank line, so the lines after its
+	// marker are wrapped from the item rather than new sentences.
+	inList := listMarker.MatchString(lines[0].text)
+
+	for i := 1; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case listMarker.MatchString(line.text):
+			inList = true
+
+			continue
+		case line.content() == "":
+			inList = false
+		}
 
+		// Indented lines are code blocks or list continuations.
+		if inList || isIndented(line.text) {
+			continue
+		}
+
+		prev := lines[i-1].content()
+		if prev == "" || strings.HasSuffix(prev, ".") || strings.HasSuffix(prev, "!") || strings.HasSuffix(prev, "?") {
+			checked = append(checked, line)
+		}
+	}
+
+	return checked
+}
+
+// isIndented checks if the text of a comment line is indented beyond the
+// single space that usually follows the comment marker.
+func isIndented(text string) bool {
+	return strings.HasPrefix(text, "\t") || strings.HasPrefix(text, "  ")
+}
+
+// docComments returns the comment groups in a file that document the
+// package, a declaration or a field.
+func docComments(file *ast.File) map[*ast.CommentGroup]bool {
+	docs := make(map[*ast.CommentGroup]bool)
+
+	add := func(cg *ast.CommentGroup) {
+		if cg != nil {
+			docs[cg] = true
+		}
+	}
+
+	add(file.Doc)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncDecl:
+			add(node.Doc)
+		case *ast.GenDecl:
+			add(node.Doc)
+		case *ast.TypeSpec:
+			add(node.Doc)
+		case *ast.ValueSpec:
+			add(node.Doc)
+		case *ast.ImportSpec:
+			add(node.Doc)
+		case *ast.Field:
+			add(node.Doc)
+		}
+
+		return true
+	})
+
+	return docs
+}
+
+// checkComment checks a comment starts with a capital letter. Comments
+// starting with a glossary term are not reported, nor, if the scope check
+// is enabled, are comments starting with a name declared by enclosing.
+func (r *runner) checkComment(pass *analysis.Pass, line commentLine, enclosing ast.Decl) {
+	// Skip directives such as //go:generate and //attgo:enum-suffixes.
+	if line.directive {
+		return
+	}
+
+	text := line.content()
 	if len(text) == 0 {
 		return
 	}
@@ -95,8 +411,115 @@ func checkComment(pass *analysis.Pass, c *ast.Comment) {
 			return
 		}
 
-		pass.Reportf(c.Pos(), "comment should start with a capital letter")
+		word := firstWord(text)
+		if r.glossary[word] {
+			return
+		}
+
+		declared := enclosing != nil && declaresName(pass, enclosing, word)
+		if declared && r.checkScope {
+			return
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     line.contentPos(),
+			Message: "comment should start with a capital letter",
+		}
+
+		// Capitalising an identifier would change its meaning, so the fix
+		// is only offered when the word is not a name in scope.
+		if !declared && !inScope(pass, word, line.pos) {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Capitalise the first letter",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     diag.Pos,
+					End:     diag.Pos + token.Pos(utf8.RuneLen(firstRune)),
+					NewText: []byte(string(unicode.ToUpper(firstRune))),
+				}},
+			}}
+		}
+
+		pass.Report(diag)
+	}
+}
+
+// inScope checks if a word names an object visible at a position, such as
+// a package-level declaration, an import or a predeclared identifier.
+func inScope(pass *analysis.Pass, word string, pos token.Pos) bool {
+	if word == "" {
+		return false
+	}
+
+	scope := pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		scope = pass.Pkg.Scope()
+	}
+
+	_, obj := scope.LookupParent(word, pos)
+
+	return obj != nil
+}
+
+// checkAllCaps checks a comment does not start with a word written entirely
+// in capitals, other than an acronym or a note marker such as "NOTE:".
+func checkAllCaps(pass *analysis.Pass, line commentLine, acronyms map[string]bool) {
+	if line.directive {
+		return
+	}
+
+	text := line.content()
+	if len(text) == 0 || shouldSkip(text) {
+		return
+	}
+
+	// Identifiers such as MAX_SIZE and note markers such as "NOTE:" and
+	// "BUG(user):" are conventional.
+	words := strings.Fields(text)
+	if strings.ContainsAny(words[0], "_:(") || describesIdentifier(words) {
+		return
+	}
+
+	word := firstWord(text)
+	if acronyms[word] || !isAllCaps(word) {
+		return
+	}
+
+	pass.Reportf(line.contentPos(), "avoid ALL-CAPS comments")
+}
+
+// isAllCaps checks if a word has at least two letters and no lowercase letters.
+func isAllCaps(word string) bool {
+	letters := 0
+
+	for _, r := range word {
+		if unicode.IsLower(r) {
+			return false
+		}
+
+		if unicode.IsLetter(r) {
+			letters++
+		}
 	}
+
+	return letters >= 2
+}
+
+// listMarker matches the start of a bulleted or numbered list item, such as
+// "- item", "* item", "1. item" or "2) item".
+var listMarker = regexp.MustCompile(`^\s*(?:[-*]|\d+[.)])\s`)
+
+// flagToken matches a command-line flag at the start of a comment, such as
+// "-v", "--output=json" or "--dry-run".
+var flagToken = regexp.MustCompile(`^--?[A-Za-z][\w-]*(?:=\S*)?(?:\s|$)`)
+
+// isPathOrFlag checks if a comment consists of a file path, such as
+// "config/app.yaml", or starts with a command-line flag.
+func isPathOrFlag(text string) bool {
+	if strings.Contains(text, "/") && !strings.ContainsAny(text, " \t") {
+		return true
+	}
+
+	return flagToken.MatchString(text)
 }
 
 // shouldSkip returns true if the comment should be skipped from checking.
@@ -196,17 +619,126 @@ func looksLikeIdentifier(text string) bool {
 		return true
 	}
 
-	// Check if followed by common identifier-describing words.
-	if len(words) >= 2 {
-		followWord := strings.ToLower(words[1])
-		identifierFollowers := []string{
-			"is", "are", "was", "were", "has", "have", "had",
-			"contains", "returns", "holds", "stores", "represents",
-			"defines", "implements", "provides", "specifies",
+	return describesIdentifier(words)
+}
+
+// describesIdentifier checks if the second word of a comment is one that
+// typically follows an identifier, as in "maxSize is..." or "EOF is...".
+func describesIdentifier(words []string) bool {
+	if len(words) < 2 {
+		return false
+	}
+
+	followWord := strings.ToLower(words[1])
+	identifierFollowers := []string{
+		"is", "are", "was", "were", "has", "have", "had",
+		"contains", "returns", "holds", "stores", "represents",
+		"defines", "implements", "provides", "specifies",
+	}
+
+	for _, follower := range identifierFollowers {
+		if followWord == follower {
+			return true
+		}
+	}
+
+	return false
+}
+
+// enclosingDecl returns the declaration a comment group documents or is
+// inside, or nil if there is none.
+func enclosingDecl(file *ast.File, cg *ast.CommentGroup) ast.Decl {
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Doc == cg {
+				return decl
+			}
+		case *ast.GenDecl:
+			if d.Doc == cg {
+				return decl
+			}
 		}
 
-		for _, follower := range identifierFollowers {
-			if followWord == follower {
+		if decl.Pos() <= cg.Pos() && cg.End() <= decl.End() {
+			return decl
+		}
+	}
+
+	return nil
+}
+
+// declaresName checks if a declaration defines an identifier with the given
+// name, such as a field, parameter, result or local variable.
+func declaresName(pass *analysis.Pass, decl ast.Decl, name string) bool {
+	if name == "" {
+		return false
+	}
+
+	found := false
+
+	ast.Inspect(decl, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == name && pass.TypesInfo.Defs[ident] != nil {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// firstWord returns the first word of a comment with any trailing
+// punctuation removed, so that "timeout, in seconds" gives "timeout".
+func firstWord(text string) string {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return ""
+	}
+
+	return strings.TrimRightFunc(words[0], unicode.IsPunct)
+}
+
+// exportedDocComments returns the doc comments attached to exported declarations in a file.
+func exportedDocComments(file *ast.File) []*ast.CommentGroup {
+	var docs []*ast.CommentGroup
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Doc != nil && d.Name.IsExported() {
+				docs = append(docs, d.Doc)
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				if !isExportedSpec(spec) {
+					continue
+				}
+
+				// An ungrouped declaration carries its doc on the GenDecl.
+				// Docs on grouped declarations are usually section headings and are not checked.
+				if d.Doc != nil && !d.Lparen.IsValid() {
+					docs = append(docs, d.Doc)
+				}
+
+				if specDoc := specDocComment(spec); specDoc != nil {
+					docs = append(docs, specDoc)
+				}
+			}
+		}
+	}
+
+	return docs
+}
+
+// isExportedSpec checks if a spec declares at least one exported name.
+func isExportedSpec(spec ast.Spec) bool {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Name.IsExported()
+	case *ast.ValueSpec:
+		for _, name := range s.Names {
+			if name.IsExported() {
 				return true
 			}
 		}
@@ -214,3 +746,66 @@ func looksLikeIdentifier(text string) bool {
 
 	return false
 }
+
+// specDocComment returns the doc comment of a spec within a grouped declaration.
+func specDocComment(spec ast.Spec) *ast.CommentGroup {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Doc
+	case *ast.ValueSpec:
+		return s.Doc
+	}
+
+	return nil
+}
+
+// checkPeriod checks that the last line of a doc comment ends with sentence-ending punctuation.
+func checkPeriod(pass *analysis.Pass, cg *ast.CommentGroup) {
+	// Text strips comment markers, directives and trailing blank lines.
+	lines := strings.Split(strings.TrimRight(cg.Text(), "\n"), "\n")
+	last := lines[len(lines)-1]
+
+	// Skip preformatted (indented) blocks such as code examples.
+	if last == "" || last[0] == ' ' || last[0] == '\t' {
+		return
+	}
+
+	last = strings.TrimSpace(last)
+	if strings.HasSuffix(last, ".") || strings.HasSuffix(last, "!") || strings.HasSuffix(last, "?") {
+		return
+	}
+
+	pass.Reportf(lastContentComment(cg).Pos(), "doc comment should end with a period")
+}
+
+// lastContentComment returns the last comment in a group that is not a directive.
+func lastContentComment(cg *ast.CommentGroup) *ast.Comment {
+	for i := len(cg.List) - 1; i > 0; i-- {
+		if !isDirective(cg.List[i].Text) {
+			return cg.List[i]
+		}
+	}
+
+	return cg.List[0]
+}
+
+// isDirective checks if a comment is a directive such as //go:generate or //nolint:foo.
+func isDirective(text string) bool {
+	text, ok := strings.CutPrefix(text, "//")
+	if !ok {
+		return false
+	}
+
+	name, _, found := strings.Cut(text, ":")
+	if !found || name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if !unicode.IsLower(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
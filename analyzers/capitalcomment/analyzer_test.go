@@ -14,6 +14,7 @@
 package capitalcomment_test
 
 import (
+	"os"
 	"testing"
 
 	"github.com/attestantio/attgo-linter/analyzers/capitalcomment"
@@ -25,3 +26,129 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, capitalcomment.Analyzer, "capitalcomment")
 }
+
+func TestAnalyzerRequirePeriod(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithRequirePeriod(true))
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentperiod")
+}
+
+func TestAnalyzerReportsFirstLetter(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	results := analysistest.Run(t, testdata, capitalcomment.Analyzer, "capitalcommentpos")
+
+	for _, result := range results {
+		for _, diag := range result.Diagnostics {
+			posn := result.Pass.Fset.Position(diag.Pos)
+
+			src, err := os.ReadFile(posn.Filename)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", posn.Filename, err)
+			}
+
+			if ch := src[posn.Offset]; ch != 't' {
+				t.Errorf("%s: diagnostic at %q, want the first letter of the comment", posn, ch)
+			}
+		}
+	}
+}
+
+func TestAnalyzerCheckScope(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithCheckScope(true))
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentscope")
+}
+
+func TestAnalyzerLowercaseGlossary(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithLowercaseGlossary([]string{"ctx", "iota", "zerolog"}))
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentglossary")
+}
+
+func TestAnalyzerNoAllCaps(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(
+		capitalcomment.WithNoAllCaps(true),
+		capitalcomment.WithAcronyms([]string{"HTTP", "ID"}),
+	)
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentallcaps")
+}
+
+func TestAnalyzerAllLines(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithMode(capitalcomment.ModeAllLines))
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentalllines")
+}
+
+func TestAnalyzerDocOnly(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithMode(capitalcomment.ModeDocOnly))
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentdoconly")
+}
+
+func TestParseMode(t *testing.T) {
+	tests := map[string]capitalcomment.Mode{
+		"first-line": capitalcomment.ModeFirstLine,
+		"all-lines":  capitalcomment.ModeAllLines,
+		"doc-only":   capitalcomment.ModeDocOnly,
+	}
+
+	for name, expected := range tests {
+		mode, err := capitalcomment.ParseMode(name)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if mode != expected {
+			t.Errorf("%s: got mode %s, want %s", name, mode, expected)
+		}
+	}
+
+	for _, name := range []string{"", "unknown", "all"} {
+		if _, err := capitalcomment.ParseMode(name); err == nil {
+			t.Errorf("%q: expected an error", name)
+		}
+	}
+}
+
+func TestAnalyzerListItems(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(
+		capitalcomment.WithMode(capitalcomment.ModeAllLines),
+		capitalcomment.WithNoAllCaps(true),
+	)
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentlists")
+}
+
+func TestAnalyzerSkipPathsAndFlags(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(
+		capitalcomment.WithMode(capitalcomment.ModeAllLines),
+		capitalcomment.WithSkipPathsAndFlags(true),
+	)
+
+	analysistest.Run(t, testdata, analyzer, "capitalcommentpaths")
+}
+
+func TestAnalyzerSuggestedFixes(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := capitalcomment.NewAnalyzer(capitalcomment.WithMode(capitalcomment.ModeAllLines))
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "capitalcommentfix")
+}
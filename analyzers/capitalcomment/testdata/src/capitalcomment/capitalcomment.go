@@ -23,6 +23,8 @@ package capitalcomment
 
 // go:generate stringer -type=Foo
 
+//attgo:enum-suffixes Type,Phase
+
 // ... continued from above
 
 // 123 is the magic number
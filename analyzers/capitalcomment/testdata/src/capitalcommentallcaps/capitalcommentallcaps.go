@@ -0,0 +1,33 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentallcaps
+
+// DO THIS CAREFULLY. // want `avoid ALL-CAPS comments`
+var counter int
+
+// HTTP requests are retried once.
+var retries = 1
+
+// ID of the current user.
+var userID string
+
+// NOTE: the order matters.
+var order = []string{"a", "b"}
+
+// BUG(jgm): the limit is not enforced.
+var limit = 10
+
+// MAX_SIZE is the largest accepted payload.
+const MAX_SIZE = 1024
+
+// A short comment starting with a single capital letter.
+var short int
+
+func process() {
+	// NEVER call this twice! // want `avoid ALL-CAPS comments`
+	counter++
+
+	// TODO: handle overflow.
+	counter++
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentalllines
+
+// Process runs the job. it then reports the result, which is wrapped
+// onto the next line.
+// then it cleans up. // want `comment should start with a capital letter`
+func Process() {}
+
+// Config holds settings.
+//
+// see the README for details. // want `comment should start with a capital letter`
+//
+//	x := Config{}
+type Config struct{}
+
+// Retry retries the call
+// until it succeeds or gives up?
+// never more than three times. // want `comment should start with a capital letter`
+func Retry() {}
+
+// this is still checked on the first line. // want `comment should start with a capital letter`
+var value int
+
+func run() {
+	// Count the items. done inside the function. // Not a new line.
+	// also checked here. // want `comment should start with a capital letter`
+	value++
+}
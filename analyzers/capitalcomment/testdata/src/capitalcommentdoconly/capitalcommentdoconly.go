@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentdoconly
+
+// process handles the request and is checked. // want `comment should start with a capital letter`
+func process() {
+	// free comments inside functions are not checked.
+	_ = 1
+}
+
+// this documents a type. // want `comment should start with a capital letter`
+type Config struct {
+	// this documents a field. // want `comment should start with a capital letter`
+	Name string
+
+	Port int // trailing comments are not checked.
+}
+
+// this documents a const group. // want `comment should start with a capital letter`
+const (
+	// this documents a constant in the group. // want `comment should start with a capital letter`
+	limit = 10
+)
+
+// a free comment between declarations is not checked.
+
+var value = limit
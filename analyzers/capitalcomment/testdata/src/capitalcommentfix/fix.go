@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentfix
+
+import "strings"
+
+// this is prose and is capitalised by the fix. // want `comment should start with a capital letter`
+var prose = 1
+
+// Fields of the options.
+type options struct {
+	// timeout in seconds, which may be a field name. // want `comment should start with a capital letter`
+	timeout int
+}
+
+// limit may name the package-level variable. // want `comment should start with a capital letter`
+var limit = 10
+
+// strings can also refer to an import. // want `comment should start with a capital letter`
+var joined = strings.Join(nil, "")
+
+// Run does some work.
+func Run() {
+	// count may name the local variable. // want `comment should start with a capital letter`
+	count := 0
+	_ = count
+
+	// ensure the work is done. // want `comment should start with a capital letter`
+	_ = prose
+}
+
+// élan starts with a multi-byte letter. // want `comment should start with a capital letter`
+var style = 2
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentglossary
+
+import "context"
+
+// zerolog configured for JSON output.
+var logFormat = "json"
+
+// iota starts at zero for the first level.
+const (
+	levelDebug = iota
+	levelInfo
+)
+
+// run is the request handler.
+func run(ctx context.Context) error {
+	// ctx for the outgoing request.
+	_ = ctx
+
+	// ctx, already cancelled, is ignored here.
+	_ = ctx
+
+	// err from the last attempt. // want `comment should start with a capital letter`
+	var err error
+
+	// zerologger writes to stderr. // want `comment should start with a capital letter`
+	_ = logFormat
+
+	return err
+}
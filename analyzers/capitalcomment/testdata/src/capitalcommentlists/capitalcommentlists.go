@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentlists
+
+// Sync copies the data in three steps.
+// 1. fetch the remote copy.
+// 2) compare it with the local copy.
+// 3. write any differences.
+//
+// then it returns. // want `comment should start with a capital letter`
+func Sync() {}
+
+// Copy copies the data.
+// - skip files that are unchanged.
+// this line wraps the item above and is not a new sentence.
+// 1. retry failed copies.
+// up to three times.
+func Copy() {}
+
+// Options controls the copy.
+// - overwrite replaces existing files.
+// * verbose logs each file.
+// - NEVER follows symbolic links.
+// 2. SKIP hidden files unless asked.
+type Options struct{}
+
+/*
+Merge combines two copies:
+ - keep entries present in both.
+ 1. drop the rest.
+*/
+func Merge() {}
+
+// Flagged below. // want +5 `comment should start with a capital letter`
+// Also flagged. // want +11 `comment should start with a capital letter`
+
+/*
+Split divides a copy.
+the parts are equal.
+
+Entries are spread evenly:
+- entries are never duplicated, and the
+last part takes any remainder.
+when the copy is uneven, the item still continues.
+
+then it returns.
+*/
+func Split() {}
+
+/* - first entries are kept. */
+var keep int
+
+// 1.5 is not a list marker, but starts with a number.
+var ratio = 1.5
+
+// -negative flags are not list items, but start with punctuation.
+var flag int
@@ -0,0 +1,26 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentpaths
+
+// config/app.yaml
+var configPath = "config/app.yaml"
+
+// cmd/attgo-linter/main.go
+var mainPath = "cmd/attgo-linter/main.go"
+
+// Run writes its results.
+//
+// --output=json
+// -v
+func Run() {}
+
+// Example invocations:
+// --dry-run reports changes without applying them.
+var dryRun bool
+
+// config files live in config/app.yaml. // want `comment should start with a capital letter`
+var configDir = "config"
+
+// not a path // want `comment should start with a capital letter`
+var notPath bool
@@ -0,0 +1,52 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License").
+
+package capitalcommentperiod
+
+// Service does things // want `doc comment should end with a period`
+type Service struct{}
+
+// Run runs the service.
+func (s *Service) Run() {}
+
+// Stop stops the service
+// once it has started // want `doc comment should end with a period`
+func (s *Service) Stop() {}
+
+// Ready reports whether the service is ready?
+func Ready() bool { return true }
+
+// Start starts everything!
+//
+//go:noinline
+func Start() {}
+
+// Example shows usage:
+//
+//	s := &Service{}
+//	s.Run()
+func Example() {}
+
+// Timeout is the default timeout // want `doc comment should end with a period`
+const Timeout = 10
+
+// Limits.
+const (
+	// MaxItems is the maximum number of items // want `doc comment should end with a period`
+	MaxItems = 100
+
+	// MinItems is the minimum number of items.
+	MinItems = 1
+)
+
+// Internal helper without a period is fine as it is unexported
+func helper() {}
+
+// Local types are not checked
+type local struct{}
+
+// Inline note without a period on an exported field is fine.
+type Config struct {
+	// Name is the name
+	Name string
+}
@@ -0,0 +1,18 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentpos
+
+// this comment has one space. // want `comment should start with a capital letter`
+var a = 1
+
+//   this comment has several spaces. // want `comment should start with a capital letter`
+var b = 2
+
+/* this block comment has one space. */ // want `comment should start with a capital letter`
+var c = 3
+
+func f() {
+	//	this comment has a tab. // want `comment should start with a capital letter`
+	_ = a
+}
@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package capitalcommentscope
+
+// Service holds settings.
+type Service struct {
+	// timeout in seconds, zero disables it.
+	timeout int
+
+	// retries before giving up.
+	retries int
+
+	// maximum number of connections. // want `comment should start with a capital letter`
+	conns int
+}
+
+// connect opens a connection.
+func connect(address string, port int) error {
+	// address without a scheme.
+	_ = address
+
+	// port for the listener.
+	_ = port
+
+	// attempt counts the retries.
+	attempt := 0
+
+	// attempt, as above.
+	_ = attempt
+
+	// something unrelated here. // want `comment should start with a capital letter`
+	return nil
+}
+
+// outside the scope of connect. // want `comment should start with a capital letter`
+var other = 1
@@ -0,0 +1,217 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package closeonce provides an analyzer that detects Close and Stop methods
+// that may close a channel twice.
+package closeonce
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const (
+	analyzerName = "attgo_close_once"
+	doc          = `detects Close and Stop methods that close a channel without a double-close guard
+
+Closing a closed channel panics, and Close or Stop methods are often called
+more than once, for example by a deferred call and on shutdown. A method
+that closes a struct-field channel should protect the close with a
+sync.Once, a flag or state check, or a select with a default case.
+
+Bad:
+    func (s *Service) Stop() {
+        close(s.done)
+    }
+
+Good:
+    func (s *Service) Stop() {
+        s.stopOnce.Do(func() {
+            close(s.done)
+        })
+    }`
+)
+
+// Analyzer is the close-once analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// checkedMethods are the method names whose channel closes are checked.
+var checkedMethods = map[string]bool{
+	"Close": true,
+	"Stop":  true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil || !checkedMethods[funcDecl.Name.Name] {
+				continue
+			}
+
+			recv := receiverObject(pass, funcDecl)
+			if recv == nil {
+				continue
+			}
+
+			checkMethod(pass, file, funcDecl, recv)
+		}
+	}
+
+	return nil, nil
+}
+
+// receiverObject returns the named receiver of a method, or nil if there is none.
+func receiverObject(pass *analysis.Pass, funcDecl *ast.FuncDecl) types.Object {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 || len(funcDecl.Recv.List[0].Names) == 0 {
+		return nil
+	}
+
+	return pass.TypesInfo.Defs[funcDecl.Recv.List[0].Names[0]]
+}
+
+func checkMethod(pass *analysis.Pass, file *ast.File, funcDecl *ast.FuncDecl, recv types.Object) {
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		field := closedField(pass, call, recv)
+		if field == "" {
+			return true
+		}
+
+		path, _ := astutil.PathEnclosingInterval(file, call.Pos(), call.End())
+		if isGuarded(pass, funcDecl, path) {
+			return true
+		}
+
+		pass.Reportf(call.Pos(), "%s closes channel %q without protection against double-close",
+			funcDecl.Name.Name, field)
+
+		return true
+	})
+}
+
+// closedField returns the name of the receiver's channel field closed by a
+// close(r.field) call, or an empty string if the call is not of that form.
+func closedField(pass *analysis.Pass, call *ast.CallExpr, recv types.Object) string {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok || ident.Name != "close" || len(call.Args) != 1 {
+		return ""
+	}
+
+	if _, isBuiltin := pass.TypesInfo.ObjectOf(ident).(*types.Builtin); !isBuiltin {
+		return ""
+	}
+
+	sel, ok := call.Args[0].(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+
+	x, ok := sel.X.(*ast.Ident)
+	if !ok || pass.TypesInfo.ObjectOf(x) != recv {
+		return ""
+	}
+
+	if _, isChan := pass.TypesInfo.TypeOf(sel).Underlying().(*types.Chan); !isChan {
+		return ""
+	}
+
+	return sel.Sel.Name
+}
+
+// isGuarded checks if a close call, given by its enclosing path, is protected
+// against running twice. The close is guarded if it runs inside a sync.Once,
+// an if statement or a select case, or follows an if statement that returns
+// early from the method.
+func isGuarded(pass *analysis.Pass, funcDecl *ast.FuncDecl, path []ast.Node) bool {
+	for i, node := range path {
+		switch n := node.(type) {
+		case *ast.IfStmt, *ast.CommClause:
+			return true
+		case *ast.CallExpr:
+			if i > 0 && isOnceDo(pass, n) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if n == funcDecl.Body && i > 0 {
+				return hasEarlyReturnBefore(n, path[i-1].Pos())
+			}
+		}
+	}
+
+	return false
+}
+
+// isOnceDo checks if a call is to the Do method of a sync.Once.
+func isOnceDo(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Do" {
+		return false
+	}
+
+	t := pass.TypesInfo.TypeOf(sel.X)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "sync" && named.Obj().Name() == "Once"
+}
+
+// hasEarlyReturnBefore checks if a block has an if statement containing a
+// return before the given position, as in `if s.closed { return }`.
+func hasEarlyReturnBefore(body *ast.BlockStmt, pos token.Pos) bool {
+	for _, stmt := range body.List {
+		if stmt.Pos() >= pos {
+			break
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if !ok {
+			continue
+		}
+
+		returns := false
+
+		ast.Inspect(ifStmt.Body, func(n ast.Node) bool {
+			if _, ok := n.(*ast.FuncLit); ok {
+				return false
+			}
+
+			if _, ok := n.(*ast.ReturnStmt); ok {
+				returns = true
+			}
+
+			return !returns
+		})
+
+		if returns {
+			return true
+		}
+	}
+
+	return false
+}
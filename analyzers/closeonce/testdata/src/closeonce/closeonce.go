@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package closeonce
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type unguarded struct {
+	done chan struct{}
+	quit chan bool
+}
+
+// Bad: closes without a guard.
+func (u *unguarded) Close() error {
+	close(u.done) // want `Close closes channel "done" without protection against double-close`
+
+	return nil
+}
+
+// Bad: closes without a guard.
+func (u *unguarded) Stop() {
+	close(u.quit) // want `Stop closes channel "quit" without protection against double-close`
+}
+
+type onceGuarded struct {
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// Good: guarded by sync.Once.
+func (o *onceGuarded) Stop() {
+	o.stopOnce.Do(func() {
+		close(o.done)
+	})
+}
+
+type flagGuarded struct {
+	mu     sync.Mutex
+	closed bool
+	done   chan struct{}
+}
+
+// Good: guarded by an early return on a flag.
+func (f *flagGuarded) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	close(f.done)
+
+	return nil
+}
+
+type casGuarded struct {
+	stopped atomic.Bool
+	done    chan struct{}
+}
+
+// Good: guarded by a compare-and-swap.
+func (c *casGuarded) Stop() {
+	if c.stopped.CompareAndSwap(false, true) {
+		close(c.done)
+	}
+}
+
+type selectGuarded struct {
+	done chan struct{}
+}
+
+// Good: guarded by a select on the channel.
+func (s *selectGuarded) Stop() {
+	select {
+	case <-s.done:
+	default:
+		close(s.done)
+	}
+}
+
+// Good: other methods are not checked.
+func (s *selectGuarded) Shutdown() {
+	close(s.done)
+}
+
+// Good: local channels are not struct fields.
+func (s *selectGuarded) Close() error {
+	results := make(chan int)
+	close(results)
+
+	return nil
+}
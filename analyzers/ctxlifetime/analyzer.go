@@ -0,0 +1,126 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ctxlifetime provides an analyzer that detects methods storing their context parameter in a struct field.
+// A request context is cancelled when the request ends, so it must not outlive the call.
+package ctxlifetime
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/attestantio/attgo-linter/internal/contextparam"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_ctx_lifetime"
+	doc          = `detects methods storing a context.Context parameter in a struct field
+
+A context passed to a method belongs to the call: it is cancelled when the
+request that created it finishes. Storing it in a struct field lets later
+calls use a context that may already be cancelled, and hides which
+deadline applies to them. Pass the context to each method that needs it
+instead.
+
+Assignments such as s.ctx = ctx, where ctx is a context.Context parameter
+of the enclosing method and s.ctx is a struct field, are reported.
+
+Bad:
+    func (s *Service) Start(ctx context.Context) {
+        s.ctx = ctx
+    }
+
+Good:
+    func (s *Service) Start(ctx context.Context) {
+        go s.run(ctx)
+    }`
+)
+
+// Analyzer is the context lifetime analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Body == nil {
+				continue
+			}
+
+			params := contextParams(pass, fn)
+			if len(params) == 0 {
+				continue
+			}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if assign, ok := n.(*ast.AssignStmt); ok {
+					checkAssign(pass, assign, params)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// contextParams returns the context.Context parameters of a function.
+func contextParams(pass *analysis.Pass, fn *ast.FuncDecl) map[types.Object]bool {
+	params := make(map[types.Object]bool)
+
+	for _, field := range fn.Type.Params.List {
+		if !contextparam.Is(field) {
+			continue
+		}
+
+		for _, name := range field.Names {
+			if obj := pass.TypesInfo.Defs[name]; obj != nil {
+				params[obj] = true
+			}
+		}
+	}
+
+	return params
+}
+
+// checkAssign reports assignments of a context parameter to a struct field.
+func checkAssign(pass *analysis.Pass, assign *ast.AssignStmt, params map[types.Object]bool) {
+	if len(assign.Lhs) != len(assign.Rhs) {
+		return
+	}
+
+	for i, lhs := range assign.Lhs {
+		sel, ok := lhs.(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+
+		selection, ok := pass.TypesInfo.Selections[sel]
+		if !ok || selection.Kind() != types.FieldVal {
+			continue
+		}
+
+		ident, ok := ast.Unparen(assign.Rhs[i]).(*ast.Ident)
+		if !ok || !params[pass.TypesInfo.Uses[ident]] {
+			continue
+		}
+
+		pass.Reportf(sel.Pos(), "do not store request context %q in struct field %q", ident.Name, sel.Sel.Name)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ctxlifetime
+
+import "context"
+
+// Service stores state between calls.
+type Service struct {
+	ctx     context.Context
+	parent  context.Context
+	name    string
+	options struct {
+		ctx context.Context
+	}
+}
+
+// Start stores the request context.
+func (s *Service) Start(ctx context.Context) {
+	s.ctx = ctx // want `do not store request context "ctx" in struct field "ctx"`
+}
+
+// Restart stores the context in several fields.
+func (s *Service) Restart(reqCtx context.Context, name string) {
+	s.parent, s.name = (reqCtx), name // want `do not store request context "reqCtx" in struct field "parent"`
+	s.options.ctx = reqCtx            // want `do not store request context "reqCtx" in struct field "ctx"`
+}
+
+// Later stores the context from a function literal.
+func (s *Service) Later(ctx context.Context) func() {
+	return func() {
+		s.ctx = ctx // want `do not store request context "ctx" in struct field "ctx"`
+	}
+}
+
+// Run passes the context on without storing it.
+func (s *Service) Run(ctx context.Context) error {
+	return s.run(ctx)
+}
+
+// Derived stores a context that is not a parameter.
+func (s *Service) Derived() {
+	s.ctx = context.Background()
+}
+
+// Local assigns the context to a local variable.
+func (s *Service) Local(ctx context.Context) {
+	current := ctx
+	current = context.WithoutCancel(ctx)
+	_ = current
+}
+
+func (s *Service) run(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// NewService stores a context in a constructor, which is not a method.
+func NewService(ctx context.Context) *Service {
+	s := &Service{}
+	s.ctx = ctx
+
+	return s
+}
@@ -0,0 +1,77 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ctxvalue provides an analyzer that checks context value keys use private types.
+package ctxvalue
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_ctx_value"
+	doc          = `checks context.WithValue keys use a private key type
+
+A context key of a built-in type such as string or int can collide with a
+key chosen by any other package. Keys should be of an unexported type
+defined by the package that owns the value.
+
+Bad:
+    ctx = context.WithValue(ctx, "user", user)
+
+Good:
+    type userKey struct{}
+
+    ctx = context.WithValue(ctx, userKey{}, user)`
+)
+
+// Analyzer is the context value analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 3 || !isWithValueCall(pass, call) {
+				return true
+			}
+
+			key := call.Args[1]
+			if _, ok := pass.TypesInfo.TypeOf(key).(*types.Basic); ok {
+				pass.Reportf(key.Pos(), "use a private context key type, not a string/int literal")
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isWithValueCall checks if a call is context.WithValue.
+func isWithValueCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "context" {
+		return false
+	}
+
+	return fn.Name() == "WithValue"
+}
@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ctxvalue
+
+import "context"
+
+type userKey struct{}
+
+type requestIDKey string
+
+const traceKey = "trace"
+
+func withValues(ctx context.Context) context.Context {
+	// Bad: string literal key.
+	ctx = context.WithValue(ctx, "user", "alice") // want `use a private context key type, not a string/int literal`
+
+	// Bad: int literal key.
+	ctx = context.WithValue(ctx, 1, "alice") // want `use a private context key type, not a string/int literal`
+
+	// Bad: untyped string constant key.
+	ctx = context.WithValue(ctx, traceKey, "abc") // want `use a private context key type, not a string/int literal`
+
+	// Good: private struct key type.
+	ctx = context.WithValue(ctx, userKey{}, "alice")
+
+	// Good: private named string key type.
+	ctx = context.WithValue(ctx, requestIDKey("id"), "123")
+
+	return ctx
+}
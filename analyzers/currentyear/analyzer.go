@@ -40,11 +40,38 @@ Also acceptable (year ranges ending in current year):
     // Copyright © 2023-2025 Attestant Limited.`
 )
 
-// Analyzer is the current year copyright analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Analyzer is the current year copyright analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the current year analyzer.
+type Option func(*runner)
+
+// WithClock sets the function used to obtain the current time, which
+// defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(r *runner) {
+		r.now = now
+	}
+}
+
+// NewAnalyzer creates a new current year analyzer with the given options.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	now func() time.Time
 }
 
 // copyrightYearPattern matches common copyright year formats.
@@ -53,10 +80,21 @@ var Analyzer = &analysis.Analyzer{
 // - Copyright 2024
 // - Copyright (c) 2024
 // - Copyright © 2023-2024 (captures last year in range)
-var copyrightYearPattern = regexp.MustCompile(`[Cc]opyright\s*(?:©|\(c\))?\s*(?:\d{4}\s*-\s*)?(\d{4})`)
+// - SPDX-FileCopyrightText: 2024
+// - SPDX-FileCopyrightText: © 2023-2024 (captures last year in range)
+var copyrightYearPattern = regexp.MustCompile(`(?:[Cc]opyright|SPDX-FileCopyrightText:)\s*(?:©|\(c\))?\s*(?:\d{4}\s*-\s*)?(\d{4})`)
+
+// copyrightMarkerPattern matches the start of a copyright notice whose year
+// does not directly follow it, for example when the year is on a later line.
+var copyrightMarkerPattern = regexp.MustCompile(`[Cc]opyright|SPDX-FileCopyrightText:`)
+
+// standaloneYearPattern matches a year or year range anywhere in the text
+// (captures last year in range).
+var standaloneYearPattern = regexp.MustCompile(`\b(?:\d{4}\s*-\s*)?(\d{4})\b`)
 
-func run(pass *analysis.Pass) (any, error) {
-	currentYear := time.Now().Year()
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	// Read the clock once so that all files in a run are checked against the same year.
+	currentYear := r.now().Year()
 
 	for _, file := range pass.Files {
 		checkFile(pass, file, currentYear)
@@ -87,21 +125,12 @@ func checkFile(pass *analysis.Pass, file *ast.File, currentYear int) {
 	}
 
 	// Extract year from copyright comment.
-	text := copyrightComment.Text()
-	matches := copyrightYearPattern.FindStringSubmatch(text)
-
-	if len(matches) < 2 {
+	year, found := copyrightYear(copyrightComment.Text())
+	if !found {
 		// No copyright year found in header - that's ok, goheader linter handles format.
 		return
 	}
 
-	yearStr := matches[1]
-
-	year, err := strconv.Atoi(yearStr)
-	if err != nil {
-		return
-	}
-
 	// Check if the year is current.
 	if year < currentYear {
 		pass.Reportf(copyrightComment.Pos(),
@@ -109,3 +138,28 @@ func checkFile(pass *analysis.Pass, file *ast.File, currentYear int) {
 			year, currentYear)
 	}
 }
+
+// copyrightYear extracts the copyright year from header text. The year is
+// taken from the copyright line where possible, otherwise from the first year
+// that follows the copyright marker elsewhere in the header.
+func copyrightYear(text string) (int, bool) {
+	matches := copyrightYearPattern.FindStringSubmatch(text)
+	if len(matches) < 2 {
+		loc := copyrightMarkerPattern.FindStringIndex(text)
+		if loc == nil {
+			return 0, false
+		}
+
+		matches = standaloneYearPattern.FindStringSubmatch(text[loc[1]:])
+		if len(matches) < 2 {
+			return 0, false
+		}
+	}
+
+	year, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return year, true
+}
@@ -15,6 +15,7 @@ package currentyear_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/attestantio/attgo-linter/analyzers/currentyear"
 	"golang.org/x/tools/go/analysis/analysistest"
@@ -23,7 +24,26 @@ import (
 func TestAnalyzer(t *testing.T) {
 	testdata := analysistest.TestData()
 
-	// Note: This test may need adjustment depending on the current year.
-	// Test fixtures use 2020 as an outdated year which should always be flagged.
-	analysistest.Run(t, testdata, currentyear.Analyzer, "currentyear")
+	// Test fixtures use 2026 as the current year and 2020 as an outdated year.
+	analyzer := currentyear.NewAnalyzer(currentyear.WithClock(fixedClock(2026, time.June, 1, 12, 0, 0)))
+	analysistest.Run(t, testdata, analyzer, "currentyear")
+}
+
+func TestYearBoundary(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	// The last second of 2026 still treats 2026 headers as current.
+	before := currentyear.NewAnalyzer(currentyear.WithClock(fixedClock(2026, time.December, 31, 23, 59, 59)))
+	analysistest.Run(t, testdata, before, "currentyear")
+
+	// The first second of 2027 makes the same headers outdated.
+	after := currentyear.NewAnalyzer(currentyear.WithClock(fixedClock(2027, time.January, 1, 0, 0, 0)))
+	analysistest.Run(t, testdata, after, "yearboundary")
+}
+
+// fixedClock returns a clock that always reports the given UTC time.
+func fixedClock(year int, month time.Month, day, hour, minute, second int) func() time.Time {
+	return func() time.Time {
+		return time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+	}
 }
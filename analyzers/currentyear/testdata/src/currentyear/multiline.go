@@ -0,0 +1,8 @@
+// Copyright © Attestant Limited, // want `copyright year 20[2]0 is outdated`
+// 2019-2020.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// The year in the expectation above is written as a character class so that
+// the header text itself carries no year other than the one on the second line.
+
+package currentyear
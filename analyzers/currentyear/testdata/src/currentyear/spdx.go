@@ -0,0 +1,4 @@
+// SPDX-FileCopyrightText: 2024 Attestant Limited // want `copyright year 2024 is outdated`
+// SPDX-License-Identifier: Apache-2.0
+
+package currentyear
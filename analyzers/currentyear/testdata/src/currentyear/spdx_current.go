@@ -0,0 +1,4 @@
+// SPDX-FileCopyrightText: 2023-2026 Attestant Limited
+// SPDX-License-Identifier: Apache-2.0
+
+package currentyear
@@ -0,0 +1,8 @@
+// Copyright © 2026 Attestant Limited. // want `copyright year 2026 is outdated; should be 2027 for new or modified files`
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+
+package yearboundary
+
+// Boundary is checked with a clock on either side of the new year.
+func Boundary() {}
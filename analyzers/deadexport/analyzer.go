@@ -0,0 +1,208 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deadexport provides an analyzer that detects exported methods on unexported types.
+package deadexport
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_dead_export"
+	doc          = `detects exported methods on unexported types
+
+Other packages cannot name an unexported type, so an exported method on it
+is usually a mistake or an unnecessary export. Methods are not reported
+when they are needed to satisfy an exported interface, or when the type is
+embedded in an exported struct and the method is promoted.
+
+Bad:
+    type worker struct{}
+
+    func (w *worker) Do() {}
+
+Good:
+    type worker struct{}
+
+    func (w *worker) do() {}`
+)
+
+// Analyzer is the dead export analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	interfaces := exportedInterfaces(pass.Pkg)
+	embedded := embeddedInExported(pass)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			method, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			named := receiverType(method)
+			if named == nil || named.Obj().Exported() || embedded[named.Obj()] {
+				continue
+			}
+
+			if satisfiesInterface(named, method.Name(), interfaces) {
+				continue
+			}
+
+			pass.Reportf(fn.Name.Pos(), "exported method %q on unexported type %q is unreachable externally",
+				method.Name(), named.Obj().Name())
+		}
+	}
+
+	return nil, nil
+}
+
+// receiverType returns the named type a method is declared on, or nil if
+// there is none.
+func receiverType(method *types.Func) *types.Named {
+	recv := method.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return nil
+	}
+
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	return named.Origin()
+}
+
+// exportedInterfaces returns the exported interfaces with methods declared
+// by a package and the packages it imports, directly or indirectly, along
+// with the predeclared error interface.
+func exportedInterfaces(pkg *types.Package) []*types.Interface {
+	interfaces := []*types.Interface{
+		types.Universe.Lookup("error").Type().Underlying().(*types.Interface),
+	}
+
+	seen := make(map[*types.Package]bool)
+
+	var visit func(p *types.Package)
+	visit = func(p *types.Package) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+
+		scope := p.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !obj.Exported() {
+				continue
+			}
+
+			iface, ok := obj.Type().Underlying().(*types.Interface)
+			if ok && iface.NumMethods() > 0 && iface.IsMethodSet() {
+				interfaces = append(interfaces, iface)
+			}
+		}
+
+		for _, imp := range p.Imports() {
+			visit(imp)
+		}
+	}
+	visit(pkg)
+
+	return interfaces
+}
+
+// satisfiesInterface checks if a type, or a pointer to it, implements one of
+// the interfaces through a method with the given name.
+func satisfiesInterface(named *types.Named, name string, interfaces []*types.Interface) bool {
+	ptr := types.NewPointer(named)
+
+	for _, iface := range interfaces {
+		if !hasMethod(iface, name) {
+			continue
+		}
+
+		if types.Implements(named, iface) || types.Implements(ptr, iface) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasMethod checks if an interface has a method with the given name.
+func hasMethod(iface *types.Interface, name string) bool {
+	for i := range iface.NumMethods() {
+		if iface.Method(i).Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// embeddedInExported returns the unexported types in a package that are
+// embedded in an exported struct type, and so have their methods promoted.
+func embeddedInExported(pass *analysis.Pass) map[*types.TypeName]bool {
+	embedded := make(map[*types.TypeName]bool)
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		obj, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || !obj.Exported() {
+			continue
+		}
+
+		st, ok := obj.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		for i := range st.NumFields() {
+			field := st.Field(i)
+			if !field.Embedded() {
+				continue
+			}
+
+			t := field.Type()
+			if ptr, ok := t.(*types.Pointer); ok {
+				t = ptr.Elem()
+			}
+
+			if named, ok := t.(*types.Named); ok {
+				embedded[named.Origin().Obj()] = true
+			}
+		}
+	}
+
+	return embedded
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package deadexport
+
+import (
+	"fmt"
+	"io"
+)
+
+// Bad: exported method on an unexported type.
+type worker struct{}
+
+func (w *worker) Do() {} // want `exported method "Do" on unexported type "worker" is unreachable externally`
+
+// Good: unexported method on an unexported type.
+func (w *worker) run() {}
+
+// Good: exported method on an exported type.
+type Service struct{}
+
+func (s *Service) Start() {}
+
+// Good: String satisfies fmt.Stringer.
+type level uint64
+
+func (l level) String() string {
+	return fmt.Sprintf("level %d", uint64(l))
+}
+
+// Good: Error satisfies the error interface.
+type parseError struct{}
+
+func (e *parseError) Error() string {
+	return "parse error"
+}
+
+// Good: Read satisfies io.Reader, but Reset satisfies nothing.
+type source struct{}
+
+func (s *source) Read(p []byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (s *source) Reset() {} // want `exported method "Reset" on unexported type "source" is unreachable externally`
+
+// Good: Handle satisfies an exported interface in this package.
+type Handler interface {
+	Handle(msg string) error
+}
+
+type echoHandler struct{}
+
+func (h echoHandler) Handle(msg string) error {
+	return nil
+}
+
+// Bad: an unexported interface does not make the method reachable.
+type closer interface {
+	Shutdown()
+}
+
+type conn struct{}
+
+func (c *conn) Shutdown() {} // want `exported method "Shutdown" on unexported type "conn" is unreachable externally`
+
+// Good: methods of an embedded type are promoted to the exported struct.
+type base struct{}
+
+func (b *base) ID() string {
+	return "base"
+}
+
+type Node struct {
+	*base
+}
+
+// Bad: generic unexported type.
+type list[T any] struct {
+	items []T
+}
+
+func (l *list[T]) Len() int { // want `exported method "Len" on unexported type "list" is unreachable externally`
+	return len(l.items)
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package deferinloop provides an analyzer that detects defer statements inside loops.
+// Deferred calls run when the function returns, not at the end of each iteration.
+package deferinloop
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_defer_in_loop"
+	doc          = `detects defer statements inside for and range loops
+
+A deferred call runs when the surrounding function returns, not when the
+loop iteration ends. Deferring inside a loop therefore accumulates one
+pending call per iteration, holding resources such as open files until
+the whole loop has finished. Move the loop body into a helper function,
+or release the resource explicitly at the end of each iteration.
+
+Defer statements in function literals called from a loop belong to the
+function literal and are not reported.
+
+Bad:
+    for _, name := range names {
+        f, err := os.Open(name)
+        if err != nil {
+            return err
+        }
+        defer f.Close()
+    }
+
+Good:
+    for _, name := range names {
+        if err := process(name); err != nil {
+            return err
+        }
+    }`
+)
+
+// Analyzer is the defer-in-loop analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		checkNode(pass, file, false)
+	}
+
+	return nil, nil
+}
+
+// checkNode reports defer statements within node. inLoop is true when node is
+// part of a loop body in the function that encloses it.
+func checkNode(pass *analysis.Pass, node ast.Node, inLoop bool) {
+	if node == nil {
+		return
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// A function literal starts a new function, so its defers run
+			// when it returns.
+			checkNode(pass, n.Body, false)
+
+			return false
+		case *ast.ForStmt:
+			checkNode(pass, n.Init, inLoop)
+			checkNode(pass, n.Cond, inLoop)
+			checkNode(pass, n.Post, inLoop)
+			checkNode(pass, n.Body, true)
+
+			return false
+		case *ast.RangeStmt:
+			checkNode(pass, n.X, inLoop)
+			checkNode(pass, n.Body, true)
+
+			return false
+		case *ast.DeferStmt:
+			if inLoop {
+				pass.Reportf(n.Pos(), "defer inside loop accumulates; move to a helper function or call explicitly")
+			}
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package deferinloop
+
+import (
+	"os"
+	"sync"
+)
+
+// Bad: each file stays open until the function returns.
+func openAll(names []string) error {
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close() // want `defer inside loop accumulates; move to a helper function or call explicitly`
+	}
+
+	return nil
+}
+
+// Bad: three-clause loop.
+func lockMany(mu *sync.Mutex, n int) {
+	for i := 0; i < n; i++ {
+		mu.Lock()
+		defer mu.Unlock() // want `defer inside loop accumulates; move to a helper function or call explicitly`
+	}
+}
+
+// Bad: nested loops.
+func nested(groups [][]string) {
+	for _, group := range groups {
+		for _, name := range group {
+			f, _ := os.Open(name)
+			defer f.Close() // want `defer inside loop accumulates; move to a helper function or call explicitly`
+		}
+		defer println(len(group)) // want `defer inside loop accumulates; move to a helper function or call explicitly`
+	}
+}
+
+// Bad: defer in a block inside a loop.
+func conditional(names []string) {
+	for _, name := range names {
+		if name != "" {
+			f, _ := os.Open(name)
+			defer f.Close() // want `defer inside loop accumulates; move to a helper function or call explicitly`
+		}
+	}
+}
+
+// Bad: a loop inside a function literal.
+var closeAll = func(files []*os.File) {
+	for _, f := range files {
+		defer f.Close() // want `defer inside loop accumulates; move to a helper function or call explicitly`
+	}
+}
+
+// Good: defer outside the loop.
+func single(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for i := 0; i < 3; i++ {
+		_ = i
+	}
+
+	return nil
+}
+
+// Good: the defer belongs to a function literal called each iteration.
+func helper(names []string) {
+	for _, name := range names {
+		func() {
+			f, _ := os.Open(name)
+			defer f.Close()
+		}()
+	}
+}
+
+// Good: defer in a goroutine started from a loop.
+func workers(wg *sync.WaitGroup, n int) {
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+		}()
+	}
+}
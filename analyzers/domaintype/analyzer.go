@@ -0,0 +1,110 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package domaintype provides an analyzer that detects identifier parameters with bare basic types.
+package domaintype
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_domain_type"
+	doc          = `detects ID parameters of exported functions typed as bare strings or integers
+
+An exported function taking several identifiers as plain strings or
+integers lets callers pass a user ID where an order ID is expected without
+any compile error. Declaring a domain type for each kind of identifier,
+such as type UserID string, makes such mistakes type errors.
+
+Parameters are identified by name: a name ending in one of the configured
+suffixes, such as userID, or equal to a suffix in lowercase, such as id.
+
+Bad:
+    func CancelOrder(userID string, orderID string) error
+
+Good:
+    func CancelOrder(userID UserID, orderID OrderID) error`
+)
+
+// NewAnalyzer creates a new domain type analyzer checking parameters whose
+// names end in one of the suffixes, such as "ID".
+func NewAnalyzer(suffixes []string) *analysis.Analyzer {
+	r := &runner{
+		suffixes: suffixes,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	suffixes []string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() {
+				continue
+			}
+
+			for _, field := range fn.Type.Params.List {
+				basic, ok := pass.TypesInfo.TypeOf(field.Type).(*types.Basic)
+				if !ok || basic.Info()&(types.IsString|types.IsInteger) == 0 {
+					continue
+				}
+
+				for _, name := range field.Names {
+					if r.isIDName(name.Name) {
+						pass.Reportf(name.Pos(),
+							"parameter %q should use a domain type, not %s", name.Name, basic.Name())
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// isIDName checks if a parameter name ends in one of the suffixes at a word
+// boundary, as in userID but not in valid, or is a suffix in lowercase.
+func (r *runner) isIDName(name string) bool {
+	for _, suffix := range r.suffixes {
+		if name == strings.ToLower(suffix) {
+			return true
+		}
+
+		prefix, ok := strings.CutSuffix(name, suffix)
+		if !ok || prefix == "" {
+			continue
+		}
+
+		if last, _ := utf8.DecodeLastRuneInString(prefix); !unicode.IsUpper(last) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,44 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package domaintype
+
+import "context"
+
+type UserID string
+
+type OrderID uint64
+
+// Bad: identifiers as bare strings and integers.
+func CancelOrder(ctx context.Context, userID string, orderID int64) error { // want `parameter "userID" should use a domain type, not string` `parameter "orderID" should use a domain type, not int64`
+	return nil
+}
+
+// Bad: a lowercase suffix on its own.
+func Lookup(id int) {} // want `parameter "id" should use a domain type, not int`
+
+// Bad: every configured suffix is checked.
+func Sign(accountKey string) {} // want `parameter "accountKey" should use a domain type, not string`
+
+// Bad: grouped names are each reported.
+func Transfer(fromID, toID string) {} // want `parameter "fromID" should use a domain type, not string` `parameter "toID" should use a domain type, not string`
+
+type Store struct{}
+
+// Bad: exported methods are checked too.
+func (s *Store) Get(userID string) {} // want `parameter "userID" should use a domain type, not string`
+
+// Good: domain types.
+func Ship(userID UserID, orderID OrderID) {}
+
+// Good: not an identifier name.
+func Validate(valid bool, name string, paid int) {}
+
+// Good: the suffix must start a new word.
+func Parse(UUID string) {}
+
+// Good: unexported functions are not checked.
+func lookup(userID string) {}
+
+// Good: slices of identifiers are not bare types.
+func Batch(userIDs []string) {}
@@ -0,0 +1,174 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package duplicatelogger provides an analyzer that detects structs with a logger of their own and an inherited one.
+package duplicatelogger
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/attestantio/attgo-linter/internal/typepattern"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_duplicate_logger"
+	doc          = `detects structs with their own logger that also embed a type with a logger
+
+A struct that declares a logger field and embeds a type carrying another
+logger has two loggers, which may be configured differently. It is unclear
+which one a method uses, and log levels or fields set on one are missing
+from the other.
+
+Logger fields are identified by the configured logger type patterns. An
+embedded type carries a logger if it, or a type embedded in it, has a field
+of a logger type.
+
+Bad:
+    type Base struct {
+        log zerolog.Logger
+    }
+
+    type Service struct {
+        Base
+        log zerolog.Logger
+    }
+
+Good:
+    type Service struct {
+        Base
+    }`
+)
+
+// NewAnalyzer creates a new duplicate logger analyzer identifying logger
+// fields by the given type patterns.
+func NewAnalyzer(loggerTypePatterns []string) *analysis.Analyzer {
+	r := &runner{
+		loggerTypePatterns: loggerTypePatterns,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	loggerTypePatterns []string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+			if !ok {
+				return true
+			}
+
+			named, ok := obj.Type().(*types.Named)
+			if !ok {
+				return true
+			}
+
+			st, ok := named.Underlying().(*types.Struct)
+			if ok && r.hasOwnLogger(st) {
+				for _, embedded := range r.embeddedWithLogger(named, st) {
+					pass.Reportf(typeSpec.Name.Pos(),
+						"type %q has its own logger and inherits one from embedded %q",
+						obj.Name(), embedded.Obj().Name())
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// hasOwnLogger checks if a struct declares a field of a logger type that is
+// not embedded.
+func (r *runner) hasOwnLogger(st *types.Struct) bool {
+	for field := range st.Fields() {
+		if !field.Embedded() && typepattern.MatchAny(field.Type(), r.loggerTypePatterns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// embeddedWithLogger returns the named types embedded in a struct that
+// carry a logger, other than the struct's own type.
+func (r *runner) embeddedWithLogger(self *types.Named, st *types.Struct) []*types.Named {
+	var res []*types.Named
+
+	for field := range st.Fields() {
+		if !field.Embedded() {
+			continue
+		}
+
+		named := embeddedNamed(field.Type())
+		if named != nil && named != self && r.carriesLogger(named, make(map[*types.Named]bool)) {
+			res = append(res, named)
+		}
+	}
+
+	return res
+}
+
+// carriesLogger checks if a named struct type, or a type embedded in it,
+// has a field of a logger type.
+func (r *runner) carriesLogger(named *types.Named, seen map[*types.Named]bool) bool {
+	if seen[named] {
+		return false
+	}
+
+	seen[named] = true
+
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for field := range st.Fields() {
+		if typepattern.MatchAny(field.Type(), r.loggerTypePatterns) {
+			return true
+		}
+
+		if embedded := embeddedNamed(field.Type()); field.Embedded() && embedded != nil && r.carriesLogger(embedded, seen) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// embeddedNamed returns the named type of an embedded field, looking
+// through a pointer, or nil if it is not named.
+func embeddedNamed(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, _ := t.(*types.Named)
+
+	return named
+}
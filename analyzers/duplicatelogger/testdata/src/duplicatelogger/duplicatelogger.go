@@ -0,0 +1,58 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package duplicatelogger
+
+import "log/slog"
+
+type Base struct {
+	log *slog.Logger
+}
+
+type Inner struct {
+	logger slog.Logger
+}
+
+// Middle carries a logger through its embedded Inner.
+type Middle struct {
+	Inner
+}
+
+type Plain struct {
+	name string
+}
+
+// Bad: its own logger and one from Base.
+type Service struct { // want `type "Service" has its own logger and inherits one from embedded "Base"`
+	Base
+	log *slog.Logger
+}
+
+// Bad: embedded through a pointer, and a logger carried further down.
+type Worker struct { // want `type "Worker" has its own logger and inherits one from embedded "Base"` `type "Worker" has its own logger and inherits one from embedded "Middle"`
+	*Base
+	Middle
+	logger slog.Logger
+}
+
+// Good: only an inherited logger.
+type Client struct {
+	Base
+}
+
+// Good: its own logger and an embedded type without one.
+type Handler struct {
+	Plain
+	log *slog.Logger
+}
+
+// Good: embedding a logger type itself is not a second logger.
+type Wrapper struct {
+	*slog.Logger
+}
+
+// Good: embedding its own type does not inherit a second logger.
+type Chain struct {
+	*Chain
+	log *slog.Logger
+}
@@ -16,9 +16,11 @@
 package enumiota
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"slices"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -31,6 +33,45 @@ const (
 Enum types should use uint64 (or another integer type) with iota, not string constants.
 The string representation should be provided via a String() method.
 
+Enum constants must not be defined from values of other named types,
+such as another enum's constants.
+
+Within a const block, only the first value of an integer enum may use
+iota. A later explicit iota expression re-anchors the sequence, so its
+constants no longer follow on from those before it and can collide with
+values declared elsewhere.
+
+Optionally, String() methods that index an array or slice with the
+receiver must guard against out-of-range values, which would otherwise
+panic.
+
+Optionally, all constants of an enum type must be declared in a single
+const block.
+
+Optionally, an integer enum whose constants start at iota must name its
+zero value <Type>Unknown. A fix inserting the Unknown constant is offered
+for const blocks that declare one constant per line with implicit values.
+
+Optionally, bitmask types, named with a Flags, Mask or Bits suffix, must
+have an unsigned integer underlying type, since shifts into the sign bit
+of a signed integer produce negative values.
+
+A file may override the configured suffixes for the types it declares
+with a directive listing its own:
+
+    //attgo:enum-suffixes Type,Status,Phase
+
+Types can be excluded from detection by their exact name, for types such
+as ContentType whose values legitimately stay strings.
+
+A type whose doc comment contains the directive //attgo:enum is an enum
+type whatever its name.
+
+String constants that must keep their value because it is used by an
+external wire protocol can be exempted from the string value check with
+the directive //attgo:wire-value in the constant's doc comment, or in the
+doc comment of its const block.
+
 Bad:
     type SANType string
     const (
@@ -46,16 +87,68 @@ Good:
         SANTypeEmail
     )
 
+    var sanTypeStrings = [...]string{"unknown", "dns", "email"}
+
     func (s SANType) String() string {
-        return [...]string{"unknown", "dns", "email"}[s]
+        if int(s) >= len(sanTypeStrings) {
+            return "unknown"
+        }
+        return sanTypeStrings[s]
     }`
 )
 
+// Option configures the enum-iota analyzer.
+type Option func(*runner)
+
+// WithSafeString sets whether String() methods on enum types must guard
+// receiver-indexed lookups with a bounds check.
+func WithSafeString(safeString bool) Option {
+	return func(r *runner) {
+		r.safeString = safeString
+	}
+}
+
+// WithSingleBlock sets whether the constants of each enum type must be
+// declared in a single const block.
+func WithSingleBlock(singleBlock bool) Option {
+	return func(r *runner) {
+		r.singleBlock = singleBlock
+	}
+}
+
+// WithRequireUnknown sets whether integer enums starting at iota must
+// declare a <Type>Unknown constant as their zero value.
+func WithRequireUnknown(requireUnknown bool) Option {
+	return func(r *runner) {
+		r.requireUnknown = requireUnknown
+	}
+}
+
+// WithUnsignedBitmask sets whether bitmask types must have an unsigned
+// integer underlying type.
+func WithUnsignedBitmask(unsignedBitmask bool) Option {
+	return func(r *runner) {
+		r.unsignedBitmask = unsignedBitmask
+	}
+}
+
+// WithExcludeTypes sets type names that are not enum types even though
+// they end with an enum type suffix. Names match exactly. The enum
+// directive still marks an excluded type as an enum.
+func WithExcludeTypes(excludeTypes []string) Option {
+	return func(r *runner) {
+		r.excludeTypes = excludeTypes
+	}
+}
+
 // NewAnalyzer creates a new enum-iota analyzer with the given enum type suffixes.
-func NewAnalyzer(enumTypeSuffixes []string) *analysis.Analyzer {
+func NewAnalyzer(enumTypeSuffixes []string, opts ...Option) *analysis.Analyzer {
 	r := &runner{
 		enumTypeSuffixes: enumTypeSuffixes,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
 
 	return &analysis.Analyzer{
 		Name: analyzerName,
@@ -66,6 +159,11 @@ func NewAnalyzer(enumTypeSuffixes []string) *analysis.Analyzer {
 
 type runner struct {
 	enumTypeSuffixes []string
+	safeString       bool
+	singleBlock      bool
+	requireUnknown   bool
+	unsignedBitmask  bool
+	excludeTypes     []string
 }
 
 func (r *runner) run(pass *analysis.Pass) (any, error) {
@@ -73,6 +171,8 @@ func (r *runner) run(pass *analysis.Pass) (any, error) {
 	enumTypes := make(map[string]*ast.TypeSpec)
 
 	for _, file := range pass.Files {
+		suffixes := r.fileSuffixes(file)
+
 		for _, decl := range file.Decls {
 			genDecl, ok := decl.(*ast.GenDecl)
 			if !ok || genDecl.Tok != token.TYPE {
@@ -85,33 +185,182 @@ func (r *runner) run(pass *analysis.Pass) (any, error) {
 					continue
 				}
 
-				// Check if the type name has an enum-like suffix.
-				if r.isEnumTypeName(typeSpec.Name.Name) {
+				// Only types with a basic underlying type can have constants.
+				if !hasBasicUnderlying(pass, typeSpec) {
+					continue
+				}
+
+				if r.unsignedBitmask {
+					checkBitmaskType(pass, typeSpec)
+				}
+
+				// Check if the type is annotated as an enum or has an enum-like suffix.
+				if hasEnumDirective(genDecl, typeSpec) || isEnumTypeName(typeSpec.Name.Name, suffixes, r.excludeTypes) {
 					enumTypes[typeSpec.Name.Name] = typeSpec
 				}
 			}
 		}
 	}
 
-	// Second pass: check const declarations that use these types.
+	// Second pass: check const declarations that use these types,
+	// and optionally their String() methods.
+	// The first const block declaring constants of each enum type.
+	firstBlocks := make(map[string]*ast.GenDecl)
+
+	// Explicit values of integer enum constants across all const blocks,
+	// keyed by type name, then by exact constant value.
+	seenValues := make(map[string]map[string]string)
+
 	for _, file := range pass.Files {
 		for _, decl := range file.Decls {
-			genDecl, ok := decl.(*ast.GenDecl)
-			if !ok || genDecl.Tok != token.CONST {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok == token.CONST {
+					r.checkConstDecl(pass, d, enumTypes, seenValues)
+
+					if r.singleBlock {
+						checkSingleBlock(pass, d, enumTypes, firstBlocks)
+					}
+
+					if r.requireUnknown {
+						checkUnknownValue(pass, d, enumTypes)
+					}
+				}
+			case *ast.FuncDecl:
+				if r.safeString {
+					checkStringMethod(pass, d, enumTypes)
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// hasBasicUnderlying checks if a type spec declares a type whose underlying
+// type is basic, such as string or uint64, rather than a struct, interface,
+// map, slice or func type.
+func hasBasicUnderlying(pass *analysis.Pass, typeSpec *ast.TypeSpec) bool {
+	obj := pass.TypesInfo.Defs[typeSpec.Name]
+	if obj == nil {
+		return false
+	}
+
+	_, ok := obj.Type().Underlying().(*types.Basic)
+
+	return ok
+}
+
+// bitmaskSuffixes are the type name suffixes that identify bitmask types.
+var bitmaskSuffixes = []string{"Flags", "Mask", "Bits"}
+
+// checkBitmaskType reports bitmask types whose underlying type is a signed
+// integer.
+func checkBitmaskType(pass *analysis.Pass, typeSpec *ast.TypeSpec) {
+	if !isEnumTypeName(typeSpec.Name.Name, bitmaskSuffixes, nil) {
+		return
+	}
+
+	basic, ok := pass.TypesInfo.Defs[typeSpec.Name].Type().Underlying().(*types.Basic)
+	if !ok {
+		return
+	}
+
+	info := basic.Info()
+	if info&types.IsInteger == 0 || info&types.IsUnsigned != 0 {
+		return
+	}
+
+	pass.Reportf(typeSpec.Name.Pos(), "bitmask enum %q should use an unsigned integer type", typeSpec.Name.Name)
+}
+
+// enumDirective is the doc comment directive that marks a type as an enum.
+const enumDirective = "//attgo:enum"
+
+// hasEnumDirective checks if a type spec, or the ungrouped declaration
+// holding it, has the enum directive in its doc comment.
+func hasEnumDirective(genDecl *ast.GenDecl, typeSpec *ast.TypeSpec) bool {
+	docs := []*ast.CommentGroup{typeSpec.Doc}
+	if !genDecl.Lparen.IsValid() {
+		docs = append(docs, genDecl.Doc)
+	}
+
+	return hasDirective(docs, enumDirective)
+}
+
+// wireValueDirective is the doc comment directive that marks string enum
+// constants whose values are fixed by an external wire protocol.
+const wireValueDirective = "//attgo:wire-value"
+
+// hasWireValueDirective checks if a const spec, or the declaration holding
+// it, has the wire value directive in its doc comment.
+func hasWireValueDirective(genDecl *ast.GenDecl, valueSpec *ast.ValueSpec) bool {
+	return hasDirective([]*ast.CommentGroup{valueSpec.Doc, genDecl.Doc}, wireValueDirective)
+}
+
+// hasDirective checks if any of the comment groups contains a directive,
+// alone or followed by a note.
+func hasDirective(docs []*ast.CommentGroup, directive string) bool {
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+
+		for _, c := range doc.List {
+			if c.Text == directive || strings.HasPrefix(c.Text, directive+" ") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// suffixesDirective is the comment directive that overrides the enum type
+// suffixes for the types declared in a file.
+const suffixesDirective = "//attgo:enum-suffixes"
+
+// fileSuffixes returns the enum type suffixes for a file: those listed in
+// its suffixes directive if it has one, or the configured suffixes.
+func (r *runner) fileSuffixes(file *ast.File) []string {
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			list, ok := strings.CutPrefix(c.Text, suffixesDirective+" ")
+			if !ok {
 				continue
 			}
 
-			r.checkConstDecl(pass, genDecl, enumTypes)
+			var suffixes []string
+
+			for suffix := range strings.SplitSeq(list, ",") {
+				if suffix = strings.TrimSpace(suffix); suffix != "" {
+					suffixes = append(suffixes, suffix)
+				}
+			}
+
+			return suffixes
 		}
 	}
 
-	return nil, nil
+	return r.enumTypeSuffixes
 }
 
 // isEnumTypeName checks if a type name appears to be an enum type based on suffix.
-func (r *runner) isEnumTypeName(name string) bool {
-	for _, suffix := range r.enumTypeSuffixes {
-		if strings.HasSuffix(name, suffix) {
+// Suffixes match case-insensitively but only as a whole CamelCase word, so
+// RequestStatus matches Status whereas Estate does not match State. Names
+// listed in excludeTypes are never enum types.
+func isEnumTypeName(name string, suffixes []string, excludeTypes []string) bool {
+	if slices.Contains(excludeTypes, name) {
+		return false
+	}
+
+	for _, suffix := range suffixes {
+		if suffix == "" || len(suffix) > len(name) {
+			continue
+		}
+
+		start := len(name) - len(suffix)
+		if strings.EqualFold(name[start:], suffix) && isWordStart(name, start) {
 			return true
 		}
 	}
@@ -119,8 +368,38 @@ func (r *runner) isEnumTypeName(name string) bool {
 	return false
 }
 
+// isWordStart checks if a CamelCase word starts at index i of name.
+func isWordStart(name string, i int) bool {
+	if i == 0 {
+		return true
+	}
+
+	if !isUpper(name[i]) {
+		return false
+	}
+
+	// Lower to upper transition, as in RequestStatus.
+	if !isUpper(name[i-1]) {
+		return true
+	}
+
+	// End of an upper case run, as in HTTPState.
+	return i+1 < len(name) && !isUpper(name[i+1])
+}
+
+// isUpper checks if a byte is an ASCII upper case letter.
+func isUpper(b byte) bool {
+	return b >= 'A' && b <= 'Z'
+}
+
 // checkConstDecl checks a const declaration for string-based enum patterns.
-func (r *runner) checkConstDecl(pass *analysis.Pass, genDecl *ast.GenDecl, enumTypes map[string]*ast.TypeSpec) {
+func (r *runner) checkConstDecl(pass *analysis.Pass, genDecl *ast.GenDecl, enumTypes map[string]*ast.TypeSpec, seenValues map[string]map[string]string) {
+	// Track integer enum types whose values have already used iota.
+	anchored := make(map[string]bool)
+
+	// The values repeated by specs without their own, as in an iota run.
+	var lastValues []ast.Expr
+
 	// Check each const spec.
 	for _, spec := range genDecl.Specs {
 		valueSpec, ok := spec.(*ast.ValueSpec)
@@ -128,6 +407,13 @@ func (r *runner) checkConstDecl(pass *analysis.Pass, genDecl *ast.GenDecl, enumT
 			continue
 		}
 
+		values := valueSpec.Values
+		if len(values) > 0 {
+			lastValues = values
+		} else {
+			values = lastValues
+		}
+
 		// Get the type of the const.
 		if len(valueSpec.Names) == 0 {
 			continue
@@ -157,10 +443,17 @@ func (r *runner) checkConstDecl(pass *analysis.Pass, genDecl *ast.GenDecl, enumT
 			continue
 		}
 
+		if isIntegerType(named.Underlying()) {
+			checkDuplicateValues(pass, valueSpec, values, named, seenValues)
+			checkIotaAnchor(pass, valueSpec, typeName, anchored)
+		}
+
+		checkUnrelatedConversions(pass, valueSpec, named)
+
 		// Check if the underlying type is string.
 		if isStringType(named.Underlying()) {
 			// Check if this const has a string literal value.
-			if hasStringLiteralValue(valueSpec) {
+			if hasStringLiteralValue(pass, valueSpec) && !hasWireValueDirective(genDecl, valueSpec) {
 				pass.Reportf(valueSpec.Pos(),
 					"enum constant %q uses string value; consider using uint64 with iota pattern instead",
 					valueSpec.Names[0].Name)
@@ -169,6 +462,279 @@ func (r *runner) checkConstDecl(pass *analysis.Pass, genDecl *ast.GenDecl, enumT
 	}
 }
 
+// checkSingleBlock reports enum constants declared in a different const
+// block to the first constants of the same type, once per block and type.
+func checkSingleBlock(pass *analysis.Pass, genDecl *ast.GenDecl, enumTypes map[string]*ast.TypeSpec, firstBlocks map[string]*ast.GenDecl) {
+	reported := make(map[string]bool)
+
+	for _, spec := range genDecl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok || len(valueSpec.Names) == 0 {
+			continue
+		}
+
+		obj := pass.TypesInfo.ObjectOf(valueSpec.Names[0])
+		if obj == nil {
+			continue
+		}
+
+		named, ok := obj.Type().(*types.Named)
+		if !ok || named.Obj().Pkg() != pass.Pkg {
+			continue
+		}
+
+		typeName := named.Obj().Name()
+		if _, isEnum := enumTypes[typeName]; !isEnum {
+			continue
+		}
+
+		first, seen := firstBlocks[typeName]
+		if !seen {
+			firstBlocks[typeName] = genDecl
+
+			continue
+		}
+
+		if first != genDecl && !reported[typeName] {
+			pass.Reportf(valueSpec.Pos(), "enum %q constants should be declared in a single const block", typeName)
+			reported[typeName] = true
+		}
+	}
+}
+
+// checkUnknownValue reports a const block whose first constant starts an
+// integer enum at iota or iota + 1 without naming the zero value
+// <Type>Unknown. If the block is simple enough, the fix inserts the
+// Unknown constant at iota and makes the old first constant implicit.
+// Values starting at iota shift up by one; those at iota + 1 are unchanged.
+func checkUnknownValue(pass *analysis.Pass, genDecl *ast.GenDecl, enumTypes map[string]*ast.TypeSpec) {
+	if len(genDecl.Specs) == 0 {
+		return
+	}
+
+	first, ok := genDecl.Specs[0].(*ast.ValueSpec)
+	if !ok || len(first.Names) != 1 || len(first.Values) != 1 || !startsAtIota(pass, first.Values[0]) {
+		return
+	}
+
+	obj := pass.TypesInfo.ObjectOf(first.Names[0])
+	if obj == nil {
+		return
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.Obj().Pkg() != pass.Pkg || !isIntegerType(named.Underlying()) {
+		return
+	}
+
+	typeName := named.Obj().Name()
+	if _, isEnum := enumTypes[typeName]; !isEnum {
+		return
+	}
+
+	unknownName := typeName + "Unknown"
+	if pass.Pkg.Scope().Lookup(unknownName) != nil {
+		// The name is taken, either by the zero value or by something else.
+		return
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     first.Pos(),
+		Message: fmt.Sprintf("enum %q should have %s as its zero value", typeName, unknownName),
+	}
+
+	if isCleanBlock(genDecl, first) {
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Add " + unknownName + " as the zero value",
+			TextEdits: []analysis.TextEdit{
+				{
+					Pos:     first.Pos(),
+					NewText: []byte(unknownName + " " + typeName + " = iota\n\t"),
+				},
+				{
+					Pos: first.Names[0].End(),
+					End: first.End(),
+				},
+			},
+		}}
+	}
+
+	pass.Report(diag)
+}
+
+// startsAtIota checks if an expression is iota or iota + 1.
+func startsAtIota(pass *analysis.Pass, expr ast.Expr) bool {
+	if isIota(pass, expr) {
+		return true
+	}
+
+	bin, ok := ast.Unparen(expr).(*ast.BinaryExpr)
+	if !ok || bin.Op != token.ADD || !isIota(pass, bin.X) {
+		return false
+	}
+
+	lit, ok := bin.Y.(*ast.BasicLit)
+
+	return ok && lit.Kind == token.INT && lit.Value == "1"
+}
+
+// checkIotaAnchor reports integer enum constants whose explicit value uses
+// iota after an earlier value of the same type in the block already did.
+func checkIotaAnchor(pass *analysis.Pass, vs *ast.ValueSpec, typeName string, anchored map[string]bool) {
+	if !slices.ContainsFunc(vs.Values, func(value ast.Expr) bool { return usesIota(pass, value) }) {
+		return
+	}
+
+	if anchored[typeName] {
+		pass.Reportf(vs.Names[0].Pos(), "enum %q re-anchors iota mid-block, which can cause value collisions", typeName)
+	}
+
+	anchored[typeName] = true
+}
+
+// usesIota checks if an expression refers to the predeclared iota.
+func usesIota(pass *analysis.Pass, expr ast.Expr) bool {
+	found := false
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if e, ok := n.(ast.Expr); ok && isIota(pass, e) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isIota checks if an expression is the predeclared iota.
+func isIota(pass *analysis.Pass, expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+
+	return ok && pass.TypesInfo.Uses[ident] == types.Universe.Lookup("iota")
+}
+
+// isCleanBlock checks if a parenthesised const block declares one constant
+// per spec, with only the first spec giving a type and value, so that
+// inserting a constant before the first spec shifts the others by one.
+func isCleanBlock(genDecl *ast.GenDecl, first *ast.ValueSpec) bool {
+	if !genDecl.Lparen.IsValid() || first.Type == nil {
+		return false
+	}
+
+	for _, spec := range genDecl.Specs[1:] {
+		vs, ok := spec.(*ast.ValueSpec)
+		if !ok || len(vs.Names) != 1 || vs.Type != nil || len(vs.Values) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkUnrelatedConversions reports enum constants whose value expression
+// involves a value of a different named type, such as a conversion of
+// another enum's constant, which mixes the two enums' values.
+func checkUnrelatedConversions(pass *analysis.Pass, vs *ast.ValueSpec, enumType *types.Named) {
+	for i, value := range vs.Values {
+		if i >= len(vs.Names) || !usesUnrelatedType(pass, value, enumType) {
+			continue
+		}
+
+		pass.Reportf(vs.Names[i].Pos(), "enum constant %q value uses unrelated type conversion", vs.Names[i].Name)
+	}
+}
+
+// usesUnrelatedType checks if any value within an expression has a named
+// type other than the enum type.
+func usesUnrelatedType(pass *analysis.Pass, expr ast.Expr, enumType *types.Named) bool {
+	found := false
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		e, ok := n.(ast.Expr)
+		if !ok || found {
+			return !found
+		}
+
+		tv, ok := pass.TypesInfo.Types[e]
+		if !ok || tv.IsType() {
+			// Type expressions, such as the type of a conversion, are not values.
+			return false
+		}
+
+		if named, ok := tv.Type.(*types.Named); ok && !types.Identical(named, enumType) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// checkDuplicateValues reports integer enum constants whose value duplicates
+// that of an earlier constant of the same type, in any const block of the
+// package. Values are the spec's own, or those it repeats.
+func checkDuplicateValues(pass *analysis.Pass, vs *ast.ValueSpec, values []ast.Expr, enumType *types.Named, seenValues map[string]map[string]string) {
+	typeName := enumType.Obj().Name()
+	if seenValues[typeName] == nil {
+		seenValues[typeName] = make(map[string]string)
+	}
+
+	for i, name := range vs.Names {
+		if name.Name == "_" {
+			continue
+		}
+
+		// A value that refers to another constant of the enum is a deliberate alias.
+		if i < len(values) && isEnumAlias(pass, values[i], enumType) {
+			continue
+		}
+
+		obj, ok := pass.TypesInfo.Defs[name].(*types.Const)
+		if !ok {
+			continue
+		}
+
+		value := obj.Val().ExactString()
+		if first, exists := seenValues[typeName][value]; exists {
+			pass.Reportf(name.Pos(), "enum constants %q and %q share value %s", first, name.Name, value)
+
+			continue
+		}
+
+		seenValues[typeName][value] = name.Name
+	}
+}
+
+// isEnumAlias checks if an expression names a constant of the enum type.
+func isEnumAlias(pass *analysis.Pass, expr ast.Expr, enumType *types.Named) bool {
+	var ident *ast.Ident
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	default:
+		return false
+	}
+
+	obj, ok := pass.TypesInfo.Uses[ident].(*types.Const)
+
+	return ok && types.Identical(obj.Type(), enumType)
+}
+
+// isIntegerType checks if a type is an integer type.
+func isIntegerType(t types.Type) bool {
+	basic, ok := t.(*types.Basic)
+	if !ok {
+		return false
+	}
+
+	return basic.Info()&types.IsInteger != 0
+}
+
 // isStringType checks if a type is string.
 func isStringType(t types.Type) bool {
 	basic, ok := t.(*types.Basic)
@@ -179,13 +745,20 @@ func isStringType(t types.Type) bool {
 	return basic.Kind() == types.String
 }
 
-// hasStringLiteralValue checks if a value spec has a string literal value.
-func hasStringLiteralValue(vs *ast.ValueSpec) bool {
+// hasStringLiteralValue checks if a value spec has a string literal value,
+// either bare or converted to a type, as in SANType("dns").
+func hasStringLiteralValue(pass *analysis.Pass, vs *ast.ValueSpec) bool {
 	if len(vs.Values) == 0 {
 		return false
 	}
 
 	for _, val := range vs.Values {
+		val = ast.Unparen(val)
+
+		if call, ok := val.(*ast.CallExpr); ok && len(call.Args) == 1 && pass.TypesInfo.Types[call.Fun].IsType() {
+			val = ast.Unparen(call.Args[0])
+		}
+
 		lit, ok := val.(*ast.BasicLit)
 		if ok && lit.Kind == token.STRING {
 			return true
@@ -194,3 +767,119 @@ func hasStringLiteralValue(vs *ast.ValueSpec) bool {
 
 	return false
 }
+
+// checkStringMethod reports String() methods on integer enum types that
+// index an array or slice with the receiver without a bounds check.
+func checkStringMethod(pass *analysis.Pass, fn *ast.FuncDecl, enumTypes map[string]*ast.TypeSpec) {
+	if fn.Name.Name != "String" || fn.Body == nil || fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return
+	}
+
+	recvField := fn.Recv.List[0]
+	if len(recvField.Names) != 1 {
+		return
+	}
+
+	recvType := recvField.Type
+	if star, ok := recvType.(*ast.StarExpr); ok {
+		recvType = star.X
+	}
+
+	recvTypeIdent, ok := recvType.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	if _, isEnum := enumTypes[recvTypeIdent.Name]; !isEnum {
+		return
+	}
+
+	recv := pass.TypesInfo.ObjectOf(recvField.Names[0])
+	if recv == nil || !isIntegerType(pass.TypesInfo.TypeOf(recvType).Underlying()) {
+		return
+	}
+
+	if hasBoundsCheck(pass, fn.Body, recv) {
+		return
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		indexExpr, ok := n.(*ast.IndexExpr)
+		if !ok || !isIndexable(pass.TypesInfo.TypeOf(indexExpr.X)) {
+			return true
+		}
+
+		if refersTo(pass, indexExpr.Index, recv) {
+			pass.Reportf(indexExpr.Pos(), "String() may panic on out-of-range enum values; add a bounds check")
+		}
+
+		return true
+	})
+}
+
+// hasBoundsCheck checks if a function body contains an if statement that
+// compares the receiver, such as if int(s) >= len(names).
+func hasBoundsCheck(pass *analysis.Pass, body *ast.BlockStmt, recv types.Object) bool {
+	found := false
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		ifStmt, ok := n.(*ast.IfStmt)
+		if !ok {
+			return true
+		}
+
+		ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+			binary, ok := n.(*ast.BinaryExpr)
+			if !ok {
+				return !found
+			}
+
+			switch binary.Op {
+			case token.LSS, token.LEQ, token.GTR, token.GEQ:
+				if refersTo(pass, binary.X, recv) || refersTo(pass, binary.Y, recv) {
+					found = true
+				}
+			}
+
+			return !found
+		})
+
+		return !found
+	})
+
+	return found
+}
+
+// isIndexable checks if a type is an array or slice, whose index expressions
+// panic when out of range.
+func isIndexable(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Array, *types.Slice:
+		return true
+	}
+
+	return false
+}
+
+// refersTo checks if an expression refers to the given object.
+func refersTo(pass *analysis.Pass, expr ast.Expr, obj types.Object) bool {
+	found := false
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[ident] == obj {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
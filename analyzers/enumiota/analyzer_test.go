@@ -35,3 +35,115 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, analyzer, "enumiota")
 }
+
+func TestAnalyzerSafeString(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	enumSuffixes := []string{
+		"Type",
+		"Status",
+		"State",
+		"Kind",
+		"Mode",
+	}
+
+	analyzer := enumiota.NewAnalyzer(enumSuffixes, enumiota.WithSafeString(true))
+
+	analysistest.Run(t, testdata, analyzer, "enumiotasafestring")
+}
+
+func TestAnalyzerSingleBlock(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	enumSuffixes := []string{
+		"Type",
+		"Status",
+		"State",
+		"Kind",
+		"Mode",
+	}
+
+	analyzer := enumiota.NewAnalyzer(enumSuffixes, enumiota.WithSingleBlock(true))
+
+	analysistest.Run(t, testdata, analyzer, "enumiotasingleblock")
+}
+
+func TestAnalyzerSuffixesDirective(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	enumSuffixes := []string{
+		"Type",
+		"Status",
+		"State",
+		"Kind",
+		"Mode",
+	}
+
+	analyzer := enumiota.NewAnalyzer(enumSuffixes)
+
+	analysistest.Run(t, testdata, analyzer, "enumiotadirective")
+}
+
+func TestAnalyzerEnumDirective(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	enumSuffixes := []string{
+		"Type",
+		"Status",
+		"State",
+		"Kind",
+		"Mode",
+	}
+
+	analyzer := enumiota.NewAnalyzer(enumSuffixes)
+
+	analysistest.Run(t, testdata, analyzer, "enumiotaannotated")
+}
+
+func TestAnalyzerRequireUnknown(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	enumSuffixes := []string{
+		"Type",
+		"Status",
+		"State",
+		"Kind",
+		"Mode",
+	}
+
+	analyzer := enumiota.NewAnalyzer(enumSuffixes, enumiota.WithRequireUnknown(true))
+
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "enumiotaunknown")
+}
+
+func TestAnalyzerNonBasicTypes(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := enumiota.NewAnalyzer([]string{"Type"})
+
+	analysistest.Run(t, testdata, analyzer, "enumiotakinds")
+}
+
+func TestAnalyzerUnsignedBitmask(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := enumiota.NewAnalyzer([]string{"Type"}, enumiota.WithUnsignedBitmask(true))
+
+	analysistest.Run(t, testdata, analyzer, "enumiotabitmask")
+}
+
+func TestAnalyzerWireValue(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := enumiota.NewAnalyzer([]string{"Type"})
+
+	analysistest.Run(t, testdata, analyzer, "enumiotawire")
+}
+
+func TestAnalyzerExcludeTypes(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := enumiota.NewAnalyzer([]string{"Type", "Kind"}, enumiota.WithExcludeTypes([]string{"ContentType", "ContentKind"}))
+
+	analysistest.Run(t, testdata, analyzer, "enumiotaexclude")
+}
@@ -56,6 +56,64 @@ const (
 	PriorityHigh   Priority = 100
 )
 
+// Bad: explicit values duplicated within an enum.
+type AccessMode uint64
+
+const (
+	AccessModeRead    AccessMode = 1
+	AccessModeWrite   AccessMode = 2
+	AccessModeExecute AccessMode = 1 // want `enum constants "AccessModeRead" and "AccessModeExecute" share value 1`
+	AccessModeAll     AccessMode = 1 + 1 + 1
+	AccessModeAdmin   AccessMode = 3 // want `enum constants "AccessModeAll" and "AccessModeAdmin" share value 3`
+)
+
+// Bad: explicit values duplicated across const blocks.
+type StorageMode uint64
+
+const (
+	StorageModeMemory StorageMode = 1
+	StorageModeDisk   StorageMode = 2
+)
+
+const (
+	StorageModeRemote StorageMode = 3
+	StorageModeCache  StorageMode = 1 // want `enum constants "StorageModeMemory" and "StorageModeCache" share value 1`
+)
+
+// Bad: iota restarted in another const block, and a value from an untyped
+// constant.
+type QueueMode uint64
+
+const queueModeDefault = 2
+
+const (
+	QueueModeUnknown QueueMode = iota
+	QueueModeFast
+	QueueModeSlow
+)
+
+const (
+	QueueModeBulk    QueueMode = iota             // want `enum constants "QueueModeUnknown" and "QueueModeBulk" share value 0`
+	QueueModeIdle                                 // want `enum constants "QueueModeFast" and "QueueModeIdle" share value 1`
+	QueueModeDefault QueueMode = queueModeDefault // want `enum constants "QueueModeSlow" and "QueueModeDefault" share value 2`
+)
+
+// Good: an alias referring to another constant is deliberate.
+type VerbosityMode uint64
+
+const (
+	VerbosityModeLow     VerbosityMode = 10
+	VerbosityModeHigh    VerbosityMode = 20
+	VerbosityModeDefault VerbosityMode = VerbosityModeLow
+)
+
+// Bad: duplicates in a multi-name spec.
+type ColorMode uint64
+
+const (
+	ColorModeRGB, ColorModeCMYK ColorMode = 1, 1 // want `enum constants "ColorModeRGB" and "ColorModeCMYK" share value 1`
+)
+
 // Not an enum: regular string type without enum suffix.
 type Name string
 
@@ -70,3 +128,53 @@ const (
 	ColorRed  Color = "red"  // No warning - Color doesn't have enum suffix.
 	ColorBlue Color = "blue" // No warning.
 )
+
+// Good: "state" is not a separate word in Estate.
+type Estate string
+
+const (
+	EstateNorth Estate = "north"
+	EstateSouth Estate = "south"
+)
+
+// Good: "type" is not a separate word in Prototype.
+type Prototype string
+
+const (
+	PrototypeAlpha Prototype = "alpha"
+	PrototypeBeta  Prototype = "beta"
+)
+
+// Bad: suffix matches case-insensitively at a word boundary.
+type CacheSTATUS string
+
+const (
+	CacheSTATUSHit CacheSTATUS = "hit" // want `enum constant "CacheSTATUSHit" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Bad: values built from another enum's constants.
+type ChannelMode uint64
+
+const (
+	ChannelModeBlocking ChannelMode = 1
+	ChannelModeAlias    ChannelMode = ChannelMode(AccessModeWrite)  // want `enum constant "ChannelModeAlias" value uses unrelated type conversion`
+	ChannelModeShifted  ChannelMode = ChannelMode(Priority(3)) + 10 // want `enum constant "ChannelModeShifted" value uses unrelated type conversion`
+	ChannelModeMixed    ChannelMode = ChannelModeBlocking | ChannelMode(2)
+	ChannelModeSized    ChannelMode = ChannelMode(len("abc")) + 20
+)
+
+// Good: an explicit conversion of iota is still an iota enum.
+type LevelStatus uint64
+
+const (
+	LevelStatusLow LevelStatus = LevelStatus(iota)
+	LevelStatusHigh
+)
+
+// Bad: string literals converted to the enum type.
+type FormatType string
+
+const (
+	FormatTypeJSON FormatType = FormatType("json")  // want `enum constant "FormatTypeJSON" uses string value; consider using uint64 with iota pattern instead`
+	FormatTypeXML  FormatType = (FormatType)("xml") // want `enum constant "FormatTypeXML" uses string value; consider using uint64 with iota pattern instead`
+)
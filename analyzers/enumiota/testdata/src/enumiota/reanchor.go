@@ -0,0 +1,66 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiota
+
+// Bad: iota re-anchored after an implicit run.
+type PhaseKind uint64
+
+const (
+	PhaseKindUnknown PhaseKind = iota
+	PhaseKindStart
+	PhaseKindEnd PhaseKind = iota // want `enum "PhaseKind" re-anchors iota mid-block, which can cause value collisions`
+)
+
+// Bad: iota re-anchored with an offset after explicit values.
+type RetryMode uint64
+
+const (
+	RetryModeUnknown RetryMode = iota
+	RetryModeOnce
+	RetryModeCustom  RetryMode = 10
+	RetryModeBackoff RetryMode = iota + 10 // want `enum "RetryMode" re-anchors iota mid-block, which can cause value collisions`
+)
+
+// Good: a single iota anchor with an offset.
+type LogLevelKind uint64
+
+const (
+	LogLevelKindDebug LogLevelKind = iota + 10
+	LogLevelKindInfo
+	LogLevelKindWarn
+)
+
+// Good: blank identifiers skip values without re-anchoring.
+type StepKind uint64
+
+const (
+	StepKindUnknown StepKind = iota
+	_
+	StepKindLast
+)
+
+// Good: each enum type in a shared block anchors once.
+type (
+	InputMode  uint64
+	OutputMode uint64
+)
+
+const (
+	InputModeUnknown InputMode = iota
+	InputModeFile
+	OutputModeUnknown OutputMode = iota
+	OutputModeFile
+)
+
+// Good: separate blocks each anchor iota.
+type SyncState uint64
+
+const (
+	SyncStateUnknown SyncState = iota
+	SyncStateRunning
+)
+
+const (
+	SyncStateDone SyncState = iota + 2
+)
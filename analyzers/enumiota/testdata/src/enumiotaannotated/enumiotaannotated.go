@@ -0,0 +1,38 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotaannotated
+
+// Color is a string-based enum without an enum suffix.
+//
+//attgo:enum
+type Color string
+
+const (
+	ColorRed  Color = "red"  // want `enum constant "ColorRed" uses string value; consider using uint64 with iota pattern instead`
+	ColorBlue Color = "blue" // want `enum constant "ColorBlue" uses string value; consider using uint64 with iota pattern instead`
+)
+
+type (
+	// Flavor is annotated inside a grouped declaration.
+	//attgo:enum flavors are persisted as strings today
+	Flavor string
+
+	// Shade is not annotated.
+	Shade string
+)
+
+const (
+	FlavorSweet Flavor = "sweet" // want `enum constant "FlavorSweet" uses string value; consider using uint64 with iota pattern instead`
+	ShadeDark   Shade  = "dark"
+)
+
+// Bad: annotated integer enums get the other checks too.
+//
+//attgo:enum
+type Weight uint64
+
+const (
+	WeightLight Weight = 1
+	WeightHeavy Weight = 1 // want `enum constants "WeightLight" and "WeightHeavy" share value 1`
+)
@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotabitmask
+
+// Bad: signed bitmask type.
+type Flags int // want `bitmask enum "Flags" should use an unsigned integer type`
+
+const (
+	FlagsRead Flags = 1 << iota
+	FlagsWrite
+)
+
+// Bad: signed bitmask with an explicit width.
+type PermissionMask int32 // want `bitmask enum "PermissionMask" should use an unsigned integer type`
+
+// Bad: suffix matches at a word boundary.
+type FeatureBits int64 // want `bitmask enum "FeatureBits" should use an unsigned integer type`
+
+// Good: unsigned bitmask type.
+type AccessFlags uint32
+
+const (
+	AccessFlagsRead AccessFlags = 1 << iota
+	AccessFlagsWrite
+)
+
+// Good: "mask" is not a separate word in Damask.
+type Damask int
+
+// Good: not an integer type.
+type NameFlags string
+
+// Good: non-basic underlying type.
+type ListMask []int
@@ -0,0 +1,23 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotadirective
+
+// Bad: files without the directive use the configured suffixes.
+type SANType string
+
+const (
+	SANTypeDNS SANType = "dns" // want `enum constant "SANTypeDNS" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Good: Phase is not a configured suffix.
+type DeployPhase string
+
+const (
+	DeployPhaseStart DeployPhase = "start"
+)
+
+// Bad: constants of a type declared under the directive are still checked here.
+const (
+	BuildPhaseLink BuildPhase = "link" // want `enum constant "BuildPhaseLink" uses string value; consider using uint64 with iota pattern instead`
+)
@@ -0,0 +1,20 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+//attgo:enum-suffixes Phase, Stage
+
+package enumiotadirective
+
+// Bad: Phase is an enum suffix in this file.
+type BuildPhase string
+
+const (
+	BuildPhaseCompile BuildPhase = "compile" // want `enum constant "BuildPhaseCompile" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Good: Type is not an enum suffix in this file.
+type ContentType string
+
+const (
+	ContentTypeJSON ContentType = "application/json"
+)
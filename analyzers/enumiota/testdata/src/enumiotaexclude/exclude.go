@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotaexclude
+
+// Good: excluded by name, as its values are MIME types.
+type ContentType string
+
+const (
+	ContentTypeJSON ContentType = "application/json"
+	ContentTypeText ContentType = "text/plain"
+)
+
+// Bad: still an enum type.
+type SANType string
+
+const (
+	SANTypeDNS SANType = "dns" // want `enum constant "SANTypeDNS" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Bad: names are matched exactly, so a longer name is still an enum type.
+type ResponseContentType string
+
+const (
+	ResponseContentTypeJSON ResponseContentType = "application/json" // want `enum constant "ResponseContentTypeJSON" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Bad: the enum directive still applies to an excluded type name.
+//
+//attgo:enum
+type ContentKind string
+
+const (
+	ContentKindImage ContentKind = "image" // want `enum constant "ContentKindImage" uses string value; consider using uint64 with iota pattern instead`
+)
@@ -0,0 +1,34 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotakinds
+
+import "enumiotakinds/ext"
+
+// Not an enum: a struct type with an enum suffix.
+type FooType struct{}
+
+// Not an enum: an interface type with an enum suffix.
+type HandlerType interface {
+	Handle()
+}
+
+// Not enums: map, slice and func types with enum suffixes.
+type (
+	LabelsType   map[string]string
+	NamesType    []string
+	CallbackType func()
+)
+
+const (
+	// No warning - the constants are of ext.FooType, not the local struct FooType.
+	FooA ext.FooType = "a"
+	FooB ext.FooType = "b"
+)
+
+// Bad: a string type with an enum suffix is still an enum.
+type ModeType string
+
+const (
+	ModeTypeFast ModeType = "fast" // want `enum constant "ModeTypeFast" uses string value; consider using uint64 with iota pattern instead`
+)
@@ -0,0 +1,12 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ext
+
+// FooType is a string enum in another package.
+type FooType string
+
+const (
+	FooTypeA FooType = "a"
+	FooTypeB FooType = "b"
+)
@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotasafestring
+
+// Bad: indexes an array literal without a bounds check.
+type SANType uint64
+
+const (
+	SANTypeUnknown SANType = iota
+	SANTypeDNS
+)
+
+func (s SANType) String() string {
+	return [...]string{"unknown", "dns"}[s] // want `String\(\) may panic on out-of-range enum values; add a bounds check`
+}
+
+// Bad: indexes a package-level array without a bounds check.
+type DataKind uint64
+
+const (
+	DataKindUnknown DataKind = iota
+	DataKindJSON
+)
+
+var dataKindStrings = [...]string{"unknown", "json"}
+
+func (d DataKind) String() string {
+	return dataKindStrings[d] // want `String\(\) may panic on out-of-range enum values; add a bounds check`
+}
+
+// Bad: pointer receiver indexing a slice.
+type ProcessState uint64
+
+var processStateStrings = []string{"idle", "running"}
+
+func (p *ProcessState) String() string {
+	return processStateStrings[*p] // want `String\(\) may panic on out-of-range enum values; add a bounds check`
+}
+
+// Good: guarded before indexing.
+type RequestStatus uint64
+
+const (
+	RequestStatusUnknown RequestStatus = iota
+	RequestStatusPending
+)
+
+var requestStatusStrings = [...]string{"unknown", "pending"}
+
+func (r RequestStatus) String() string {
+	if int(r) >= len(requestStatusStrings) {
+		return "unknown"
+	}
+
+	return requestStatusStrings[r]
+}
+
+// Good: index inside a guarding if.
+type AccessMode uint64
+
+func (a AccessMode) String() string {
+	if a < AccessMode(len(requestStatusStrings)) {
+		return [...]string{"read", "write"}[a]
+	}
+
+	return "unknown"
+}
+
+// Good: switch does not index.
+type ColorMode uint64
+
+func (c ColorMode) String() string {
+	switch c {
+	case 0:
+		return "rgb"
+	default:
+		return "unknown"
+	}
+}
+
+// Good: not an enum type.
+type Index uint64
+
+func (i Index) String() string {
+	return [...]string{"a", "b"}[i]
+}
@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotasingleblock
+
+// Bad: constants split across blocks.
+type DataKind uint64
+
+const (
+	DataKindUnknown DataKind = iota
+	DataKindJSON
+)
+
+const (
+	DataKindXML  DataKind = 2 // want `enum "DataKind" constants should be declared in a single const block`
+	DataKindYAML DataKind = 3
+)
+
+const DataKindCSV DataKind = 4 // want `enum "DataKind" constants should be declared in a single const block`
+
+// Good: all constants in one block.
+type RequestStatus uint64
+
+const (
+	RequestStatusUnknown RequestStatus = iota
+	RequestStatusPending
+	RequestStatusDone
+)
+
+// Good: a block may hold constants of several types.
+type ProcessState uint64
+
+type AccessMode uint64
+
+const (
+	ProcessStateIdle ProcessState = iota
+	ProcessStateRunning
+	AccessModeRead AccessMode = 1
+)
+
+// Good: not an enum type.
+type Count uint64
+
+const CountOne Count = 1
+
+const CountTwo Count = 2
@@ -0,0 +1,54 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotaunknown
+
+// Good: the zero value is named Unknown.
+type DataKind uint64
+
+const (
+	DataKindUnknown DataKind = iota
+	DataKindJSON
+)
+
+// Bad: the zero value is a meaningful state.
+type ProcessState uint64
+
+const (
+	ProcessStateIdle ProcessState = iota // want `enum "ProcessState" should have ProcessStateUnknown as its zero value`
+	ProcessStateRunning
+	ProcessStateStopped
+)
+
+// Bad: the zero value is not named.
+type AccessMode uint64
+
+const (
+	AccessModeRead AccessMode = iota + 1 // want `enum "AccessMode" should have AccessModeUnknown as its zero value`
+	AccessModeWrite
+)
+
+// Bad: not a clean block, so no fix is offered.
+type ColorMode uint64
+
+const (
+	ColorModeRGB ColorMode = iota // want `enum "ColorMode" should have ColorModeUnknown as its zero value`
+	ColorModeCMYK
+	colorModeCount = 2
+)
+
+// Good: explicit values are not checked.
+type Priority uint64
+
+const (
+	PriorityLow  Priority = 10
+	PriorityHigh Priority = 20
+)
+
+// Good: not an enum type name.
+type Color uint64
+
+const (
+	ColorRed Color = iota
+	ColorBlue
+)
@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package enumiotawire
+
+// CipherType names a cipher suite as sent on the wire.
+type CipherType string
+
+const (
+	// CipherTypeAES is sent as-is to peers.
+	//
+	//attgo:wire-value
+	CipherTypeAES CipherType = "aes-256-gcm"
+
+	//attgo:wire-value names fixed by RFC 8439
+	CipherTypeChaCha CipherType = "chacha20-poly1305"
+
+	CipherTypeNone CipherType = "none" // want `enum constant "CipherTypeNone" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Good: the directive on a const block covers all its constants.
+type HeaderType string
+
+//attgo:wire-value
+const (
+	HeaderTypeJSON HeaderType = "application/json"
+	HeaderTypeSSZ  HeaderType = "application/octet-stream"
+)
+
+//attgo:wire-value
+const HeaderTypeText HeaderType = "text/plain"
+
+// Bad: a line comment is not a doc comment.
+type FrameType string
+
+const (
+	FrameTypeData FrameType = "data" //attgo:wire-value // want `enum constant "FrameTypeData" uses string value; consider using uint64 with iota pattern instead`
+)
+
+// Bad: a similar directive name is not recognised.
+type PacketType string
+
+const (
+	//attgo:wire-values
+	PacketTypeData PacketType = "data" // want `enum constant "PacketTypeData" uses string value; consider using uint64 with iota pattern instead`
+)
@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package envread provides an analyzer that checks environment variables are read only by configuration code.
+package envread
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_env_read"
+	doc          = `checks environment variables are read only in configuration packages
+
+Reading environment variables throughout the code hides configuration and
+makes it hard to test. Values should be read once, in the configuration
+package or in main, and passed to the code that needs them.
+
+Calls to os.Getenv and os.LookupEnv are reported outside the allowed
+packages and their subpackages. Package main and _test.go files are always
+allowed.
+
+Bad:
+    func (s *Service) timeout() time.Duration {
+        d, _ := time.ParseDuration(os.Getenv("TIMEOUT"))
+        return d
+    }
+
+Good:
+    func New(params ...Parameter) (*Service, error) {
+        parameters, err := parseAndCheckParameters(params...)
+        ...
+        return &Service{timeout: parameters.timeout}, nil
+    }`
+)
+
+// NewAnalyzer creates a new env-read analyzer.
+// Calls in allowedPackages, given as package paths, or their subpackages
+// are not reported.
+func NewAnalyzer(allowedPackages []string) *analysis.Analyzer {
+	r := &runner{
+		allowedPackages: allowedPackages,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	allowedPackages []string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Name() == "main" || r.isAllowedPackage(pass.Pkg.Path()) {
+		return nil, nil
+	}
+
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Package).Filename
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			if isEnvRead(pass, call) {
+				pass.Reportf(call.Pos(), "read environment variables only in the config package")
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isAllowedPackage checks if a package path is one of the allowed packages
+// or a subpackage of one.
+func (r *runner) isAllowedPackage(path string) bool {
+	for _, allowed := range r.allowedPackages {
+		if path == allowed || strings.HasPrefix(path, allowed+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isEnvRead checks if a call is os.Getenv or os.LookupEnv.
+func isEnvRead(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "os" {
+		return false
+	}
+
+	return fn.Name() == "Getenv" || fn.Name() == "LookupEnv"
+}
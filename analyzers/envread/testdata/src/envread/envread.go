@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package envread
+
+import (
+	"os"
+	goos "os"
+)
+
+type service struct {
+	endpoint string
+}
+
+func (s *service) timeout() string {
+	return os.Getenv("TIMEOUT") // want `read environment variables only in the config package`
+}
+
+func (s *service) debug() bool {
+	_, ok := goos.LookupEnv("DEBUG") // want `read environment variables only in the config package`
+
+	return ok
+}
+
+// Good: other os functions are not reported.
+func (s *service) hostname() string {
+	name, _ := os.Hostname()
+
+	return name
+}
+
+// Good: a method with the same name is not os.Getenv.
+type environment map[string]string
+
+func (e environment) Getenv(key string) string {
+	return e[key]
+}
+
+func (s *service) fromEnvironment(e environment) string {
+	return e.Getenv("ENDPOINT")
+}
@@ -0,0 +1,14 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package envread
+
+import (
+	"os"
+	"testing"
+)
+
+// Good: tests may read the environment.
+func TestTimeout(t *testing.T) {
+	_ = os.Getenv("TIMEOUT")
+}
@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package main
+
+import "os"
+
+// Good: main is where configuration is gathered.
+func main() {
+	_ = os.Getenv("LOG_LEVEL")
+}
@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package config
+
+import "os"
+
+// Good: the config package may read the environment.
+func Endpoint() string {
+	return os.Getenv("ENDPOINT")
+}
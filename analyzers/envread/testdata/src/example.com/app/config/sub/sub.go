@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package sub
+
+import "os"
+
+// Good: subpackages of the config package may read the environment.
+func Region() string {
+	return os.Getenv("REGION")
+}
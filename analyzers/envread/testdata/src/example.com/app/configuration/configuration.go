@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package configuration
+
+import "os"
+
+// Bad: a package sharing a prefix with the config package is not allowed.
+func Endpoint() string {
+	return os.Getenv("ENDPOINT") // want `read environment variables only in the config package`
+}
@@ -0,0 +1,157 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errstring provides an analyzer that checks error strings follow Go conventions.
+package errstring
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_err_string"
+	doc          = `checks error strings are not capitalized and do not end with punctuation
+
+Error strings are usually wrapped or printed after other context, so they
+should not be capitalized (unless they start with an initialism or proper
+noun) or end with punctuation.
+
+Bad:
+    errors.New("Failed to connect.")
+
+Good:
+    errors.New("failed to connect")`
+)
+
+// Analyzer is the error string analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 || !isErrorConstructor(pass, call) {
+				return true
+			}
+
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+
+			checkErrorString(pass, lit)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isErrorConstructor checks if a call is to errors.New or fmt.Errorf.
+func isErrorConstructor(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+
+	switch fn.Pkg().Path() {
+	case "errors":
+		return fn.Name() == "New"
+	case "fmt":
+		return fn.Name() == "Errorf"
+	}
+
+	return false
+}
+
+func checkErrorString(pass *analysis.Pass, lit *ast.BasicLit) {
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil || value == "" {
+		return
+	}
+
+	if isCapitalized(value) {
+		diag := analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			Message: "error strings should not be capitalized",
+		}
+
+		// Only offer a fix when the first character is written as-is in the source.
+		if first, size := utf8.DecodeRuneInString(lit.Value[1:]); first != '\\' {
+			start := lit.Pos() + 1
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Lowercase the first letter",
+				TextEdits: []analysis.TextEdit{{
+					Pos:     start,
+					End:     start + token.Pos(size),
+					NewText: []byte(string(unicode.ToLower(first))),
+				}},
+			}}
+		}
+
+		pass.Report(diag)
+	}
+
+	if last := value[len(value)-1]; last == '.' || last == ':' || last == '!' {
+		diag := analysis.Diagnostic{
+			Pos:     lit.Pos(),
+			Message: "error strings should not end with punctuation",
+		}
+
+		// Only offer a fix when the punctuation is written as-is before the closing quote.
+		if lit.Value[len(lit.Value)-2] == last {
+			end := lit.End() - 1
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message: "Remove the trailing punctuation",
+				TextEdits: []analysis.TextEdit{{
+					Pos: end - 1,
+					End: end,
+				}},
+			}}
+		}
+
+		pass.Report(diag)
+	}
+}
+
+// isCapitalized checks if an error string starts with a capital letter that
+// is not part of an initialism or identifier, such as "HTTP" or "IDs".
+func isCapitalized(value string) bool {
+	first, _ := utf8.DecodeRuneInString(value)
+	if !unicode.IsUpper(first) {
+		return false
+	}
+
+	word, _, _ := strings.Cut(value, " ")
+	for _, r := range word[utf8.RuneLen(first):] {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+
+	return true
+}
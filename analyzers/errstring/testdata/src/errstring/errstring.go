@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package errstring
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Bad: capitalized.
+var errCapital = errors.New("Failed to connect") // want `error strings should not be capitalized`
+
+// Bad: trailing period.
+var errPeriod = errors.New("failed to connect.") // want `error strings should not end with punctuation`
+
+// Bad: both, in fmt.Errorf.
+func wrap(err error) error {
+	return fmt.Errorf("Could not start: %w!", err) // want `error strings should not be capitalized` `error strings should not end with punctuation`
+}
+
+// Bad: trailing colon in a raw string.
+var errColon = errors.New(`missing value:`) // want `error strings should not end with punctuation`
+
+// Good: starts with an initialism.
+var errHTTP = errors.New("HTTP request failed")
+
+// Good: starts with an identifier.
+var errIDs = errors.New("IDs must be unique")
+
+// Good: conventional error string.
+var errGood = errors.New("failed to connect")
+
+// Good: not an error constructor.
+func print() {
+	fmt.Printf("Done.")
+}
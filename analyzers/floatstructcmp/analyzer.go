@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package floatstructcmp provides an analyzer that detects == and != on structs containing floats.
+package floatstructcmp
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_float_struct_cmp"
+	doc          = `detects == and != on structs containing float fields
+
+Comparing structs with == compares every field exactly, so a struct holding
+a float is unequal to another that differs only by rounding, and a struct
+holding NaN is unequal even to itself. Such structs should be compared
+field by field, using a tolerance for the floats.
+
+Fields of nested structs and arrays are included, as are complex numbers,
+which are made of floats.
+
+Bad:
+    type Point struct {
+        X, Y float64
+    }
+
+    if a == b {
+
+Good:
+    if math.Abs(a.X-b.X) < epsilon && math.Abs(a.Y-b.Y) < epsilon {`
+)
+
+// Analyzer is the float struct comparison analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			expr, ok := n.(*ast.BinaryExpr)
+			if !ok || (expr.Op != token.EQL && expr.Op != token.NEQ) {
+				return true
+			}
+
+			t := pass.TypesInfo.TypeOf(expr.X)
+			if t == nil {
+				return true
+			}
+
+			if _, ok := t.Underlying().(*types.Struct); ok && containsFloat(t) {
+				pass.Reportf(expr.OpPos, "avoid %s on struct %q containing float fields",
+					expr.Op, types.TypeString(t, types.RelativeTo(pass.Pkg)))
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// containsFloat checks if a value of a type holds a float, directly or in a
+// field of a nested struct or an element of an array.
+func containsFloat(t types.Type) bool {
+	switch underlying := t.Underlying().(type) {
+	case *types.Basic:
+		return underlying.Info()&(types.IsFloat|types.IsComplex) != 0
+	case *types.Array:
+		return containsFloat(underlying.Elem())
+	case *types.Struct:
+		for i := range underlying.NumFields() {
+			if containsFloat(underlying.Field(i).Type()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package floatstructcmp
+
+// Point holds float coordinates.
+type Point struct {
+	X, Y float64
+}
+
+// Segment holds floats in nested structs.
+type Segment struct {
+	From, To Point
+}
+
+// Polygon holds floats in an array.
+type Polygon struct {
+	Corners [4]Point
+}
+
+// Signal holds a complex number.
+type Signal struct {
+	Value complex128
+}
+
+// Label holds no floats.
+type Label struct {
+	Text  string
+	Count int
+}
+
+// Shape holds a pointer to a struct with floats, which is compared by address.
+type Shape struct {
+	Origin *Point
+	Points []Point
+}
+
+func compare(a, b Point, s, t Segment, p, q Polygon, x, y Signal, l, m Label, h, k Shape) bool {
+	if a == b { // want `avoid == on struct "Point" containing float fields`
+		return true
+	}
+
+	if s != t { // want `avoid != on struct "Segment" containing float fields`
+		return false
+	}
+
+	_ = p == q // want `avoid == on struct "Polygon" containing float fields`
+	_ = x == y // want `avoid == on struct "Signal" containing float fields`
+
+	_ = struct{ F float32 }{} == struct{ F float32 }{} // want `avoid == on struct "struct{F float32}" containing float fields`
+
+	// No warning: no float fields.
+	_ = l == m
+
+	// No warning: pointers are compared by address.
+	_ = h.Origin == k.Origin
+	_ = &a == &b
+
+	// No warning: floats compared directly are a different concern.
+	_ = a.X == b.X
+
+	return false
+}
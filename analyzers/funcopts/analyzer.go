@@ -19,6 +19,8 @@ import (
 	"go/types"
 	"strings"
 
+	"github.com/attestantio/attgo-linter/internal/contextparam"
+	"github.com/attestantio/attgo-linter/internal/servicetype"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -42,25 +44,42 @@ Good:
     func New(opts ...Option) *Service`
 )
 
-// Analyzer is the functional options analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Analyzer is the functional options analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the functional options analyzer.
+type Option func(*runner)
+
+// WithAllowBuilders sets whether constructors returning a builder are exempt.
+// A builder is a type whose name ends in Builder, or that has With... methods.
+// Default: true.
+func WithAllowBuilders(allowBuilders bool) Option {
+	return func(r *runner) {
+		r.allowBuilders = allowBuilders
+	}
 }
 
-// serviceTypeSuffixes are suffixes that identify service types.
-var serviceTypeSuffixes = []string{
-	"Service",
-	"Manager",
-	"Handler",
-	"Controller",
-	"Provider",
-	"Client",
-	"Server",
+// NewAnalyzer creates a new functional options analyzer with the given options.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{
+		allowBuilders: true,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
 }
 
-func run(pass *analysis.Pass) (any, error) {
+type runner struct {
+	allowBuilders bool
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
 	// Collect service types.
 	serviceTypes := make(map[string]bool)
 
@@ -82,7 +101,7 @@ func run(pass *analysis.Pass) (any, error) {
 					continue
 				}
 
-				if isServiceTypeName(typeSpec.Name.Name) {
+				if servicetype.IsName(typeSpec.Name.Name) {
 					serviceTypes[typeSpec.Name.Name] = true
 				}
 			}
@@ -113,6 +132,11 @@ func run(pass *analysis.Pass) (any, error) {
 				continue
 			}
 
+			// Builders are configured by chained With... calls instead.
+			if r.allowBuilders && isBuilderType(pass, returnType) {
+				continue
+			}
+
 			// Check parameters - warn if more than 2 non-context parameters.
 			if shouldSuggestFuncOpts(funcDecl) {
 				pass.Reportf(funcDecl.Name.Pos(),
@@ -125,10 +149,21 @@ func run(pass *analysis.Pass) (any, error) {
 	return nil, nil
 }
 
-// isServiceTypeName checks if a type name looks like a service.
-func isServiceTypeName(name string) bool {
-	for _, suffix := range serviceTypeSuffixes {
-		if strings.HasSuffix(name, suffix) {
+// isBuilderType checks if the named package type is a builder: its name ends
+// in Builder, or it or its pointer has methods whose names start with With.
+func isBuilderType(pass *analysis.Pass, name string) bool {
+	if strings.HasSuffix(name, "Builder") {
+		return true
+	}
+
+	typeName, ok := pass.Pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return false
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(typeName.Type()))
+	for i := range methods.Len() {
+		if strings.HasPrefix(methods.At(i).Obj().Name(), "With") {
 			return true
 		}
 	}
@@ -167,7 +202,7 @@ func shouldSuggestFuncOpts(fn *ast.FuncDecl) bool {
 
 	for _, param := range fn.Type.Params.List {
 		// Check if this is a context parameter.
-		if isContextParam(param) {
+		if contextparam.Is(param) {
 			continue
 		}
 
@@ -176,7 +211,9 @@ func shouldSuggestFuncOpts(fn *ast.FuncDecl) bool {
 			return false // Already using func opts pattern.
 		}
 
-		// Count non-context parameters (accounting for multiple names).
+		// Count non-context parameters (accounting for multiple names,
+		// including blank ones such as "_, _ int"). A field with no names is
+		// a single unnamed parameter.
 		names := len(param.Names)
 		if names == 0 {
 			names = 1
@@ -189,21 +226,6 @@ func shouldSuggestFuncOpts(fn *ast.FuncDecl) bool {
 	return nonContextParams > 3
 }
 
-// isContextParam checks if a parameter is a context.Context.
-func isContextParam(param *ast.Field) bool {
-	sel, ok := param.Type.(*ast.SelectorExpr)
-	if !ok {
-		return false
-	}
-
-	ident, ok := sel.X.(*ast.Ident)
-	if !ok {
-		return false
-	}
-
-	return ident.Name == "context" && sel.Sel.Name == "Context"
-}
-
 // isOptionsParam checks if a parameter looks like a functional option.
 func isOptionsParam(param *ast.Field) bool {
 	// Check for variadic.
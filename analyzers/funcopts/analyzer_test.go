@@ -25,3 +25,16 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, funcopts.Analyzer, "funcopts")
 }
+
+func TestAnalyzerAllowBuilders(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, funcopts.Analyzer, "funcoptsbuilder")
+}
+
+func TestAnalyzerDisallowBuilders(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := funcopts.NewAnalyzer(funcopts.WithAllowBuilders(false))
+	analysistest.Run(t, testdata, analyzer, "funcoptsnobuilder")
+}
@@ -55,5 +55,37 @@ func NewHelper(a, b, c, d interface{}) *Helper {
 	return &Helper{}
 }
 
+// FooService is a service type.
+type FooService struct{}
+
+// Bad: four blank parameters count as four.
+func NewFooService(_ int, _ string, _ bool, _ float64) *FooService { // want `constructor "NewFooService" has many parameters; consider using functional options pattern`
+	return &FooService{}
+}
+
+// BarService is a service type.
+type BarService struct{}
+
+// Bad: blank identifiers declared in one field count individually.
+func NewBarService(_, _ int, _, _ string) *BarService { // want `constructor "NewBarService" has many parameters; consider using functional options pattern`
+	return &BarService{}
+}
+
+// BazService is a service type.
+type BazService struct{}
+
+// Bad: unnamed parameters count one each.
+func NewBazService(int, string, bool, float64) *BazService { // want `constructor "NewBazService" has many parameters; consider using functional options pattern`
+	return &BazService{}
+}
+
+// QuxService is a service type.
+type QuxService struct{}
+
+// Good: three blank parameters and a context.
+func NewQuxService(_ context.Context, _, _ int, _ string) *QuxService {
+	return &QuxService{}
+}
+
 // Non-constructor function - not checked.
 func ProcessData(a, b, c, d, e interface{}) {}
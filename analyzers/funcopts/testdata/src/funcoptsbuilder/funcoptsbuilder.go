@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package funcoptsbuilder
+
+import "time"
+
+// APIClient is configured by chained With... calls.
+type APIClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+// Good: the returned type is a builder.
+func NewAPIClient(addr, user, password, token string) *APIClient {
+	return &APIClient{addr: addr}
+}
+
+// WithTimeout sets the timeout.
+func (c *APIClient) WithTimeout(timeout time.Duration) *APIClient {
+	c.timeout = timeout
+
+	return c
+}
+
+// CacheService has no builder methods.
+type CacheService struct{}
+
+// Bad: not a builder.
+func NewCacheService(a, b, c, d string) *CacheService { // want `constructor "NewCacheService" has many parameters; consider using functional options pattern`
+	return &CacheService{}
+}
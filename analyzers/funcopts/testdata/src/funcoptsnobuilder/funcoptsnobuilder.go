@@ -0,0 +1,24 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package funcoptsnobuilder
+
+import "time"
+
+// APIClient is configured by chained With... calls.
+type APIClient struct {
+	addr    string
+	timeout time.Duration
+}
+
+// Bad: builders are not exempt when disallowed.
+func NewAPIClient(addr, user, password, token string) *APIClient { // want `constructor "NewAPIClient" has many parameters; consider using functional options pattern`
+	return &APIClient{addr: addr}
+}
+
+// WithTimeout sets the timeout.
+func (c *APIClient) WithTimeout(timeout time.Duration) *APIClient {
+	c.timeout = timeout
+
+	return c
+}
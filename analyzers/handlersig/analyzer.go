@@ -0,0 +1,112 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package handlersig provides an analyzer that checks HTTP handler parameter order.
+package handlersig
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_handler_sig"
+	doc          = `checks HTTP handlers take (http.ResponseWriter, *http.Request) first
+
+A function taking both an http.ResponseWriter and an *http.Request should
+take them as its first two parameters, in that order, matching
+http.HandlerFunc. Further parameters may follow them.
+
+Bad:
+    func handle(r *http.Request, w http.ResponseWriter)
+    func handle(ctx context.Context, w http.ResponseWriter, r *http.Request)
+
+Good:
+    func handle(w http.ResponseWriter, r *http.Request)
+    func handle(w http.ResponseWriter, r *http.Request, next http.Handler)`
+)
+
+// Analyzer is the handler signature analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			var funcType *ast.FuncType
+
+			switch fn := n.(type) {
+			case *ast.FuncDecl:
+				funcType = fn.Type
+			case *ast.FuncLit:
+				funcType = fn.Type
+			default:
+				return true
+			}
+
+			if !isValidSignature(pass, funcType) {
+				pass.Reportf(funcType.Pos(), "handler should have signature (http.ResponseWriter, *http.Request)")
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isValidSignature checks if a function that takes both an
+// http.ResponseWriter and an *http.Request takes them first, in order.
+// Functions that do not take both are always valid.
+func isValidSignature(pass *analysis.Pass, funcType *ast.FuncType) bool {
+	writerIndex, requestIndex := -1, -1
+	index := 0
+
+	for _, field := range funcType.Params.List {
+		count := max(len(field.Names), 1)
+		t := pass.TypesInfo.TypeOf(field.Type)
+
+		if writerIndex < 0 && isHTTPType(t, "ResponseWriter") {
+			writerIndex = index
+		}
+
+		if ptr, ok := t.(*types.Pointer); ok && requestIndex < 0 && isHTTPType(ptr.Elem(), "Request") {
+			requestIndex = index
+		}
+
+		index += count
+	}
+
+	if writerIndex < 0 || requestIndex < 0 {
+		return true
+	}
+
+	return writerIndex == 0 && requestIndex == 1
+}
+
+// isHTTPType checks if a type is the named type from net/http.
+func isHTTPType(t types.Type, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "net/http" && obj.Name() == name
+}
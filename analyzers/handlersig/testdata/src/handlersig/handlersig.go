@@ -0,0 +1,37 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package handlersig
+
+import (
+	"context"
+	"net/http"
+)
+
+// Bad: transposed parameters.
+func transposed(r *http.Request, w http.ResponseWriter) {} // want `handler should have signature \(http.ResponseWriter, \*http.Request\)`
+
+// Bad: extra parameter before the pair.
+func withContext(ctx context.Context, w http.ResponseWriter, r *http.Request) {} // want `handler should have signature \(http.ResponseWriter, \*http.Request\)`
+
+type server struct{}
+
+// Bad: transposed method.
+func (s *server) serve(r *http.Request, w http.ResponseWriter) {} // want `handler should have signature \(http.ResponseWriter, \*http.Request\)`
+
+// Good: standard signature.
+func handle(w http.ResponseWriter, r *http.Request) {}
+
+// Good: extra parameters after the pair.
+func middleware(w http.ResponseWriter, r *http.Request, next http.Handler) {}
+
+// Good: only one of the pair.
+func writeError(w http.ResponseWriter, status int) {}
+
+func routes() {
+	// Bad: transposed function literal.
+	_ = func(r *http.Request, w http.ResponseWriter) {} // want `handler should have signature \(http.ResponseWriter, \*http.Request\)`
+
+	// Good: function literal handler.
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+}
@@ -0,0 +1,145 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ignorederror provides an analyzer that checks discarded errors are explained.
+package ignorederror
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_ignored_error"
+	doc          = `checks that errors assigned to _ are explained with a comment
+
+Discarding an error should be a deliberate decision that readers can see.
+An assignment of an error result to the blank identifier must have a
+comment on the same line, or on the line before, saying why the error can
+be ignored. The comment must start with "ignore", in any case, or be a
+nolint directive.
+
+Bad:
+    _ = conn.Close()
+
+Good:
+    // Ignore error because the connection is already broken.
+    _ = conn.Close()`
+)
+
+// Analyzer is the ignored error analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		explained := explanationLines(pass.Fset, file)
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok {
+				return true
+			}
+
+			for _, blank := range blankErrors(pass, assign) {
+				line := pass.Fset.Position(blank.Pos()).Line
+				if !explained[line] && !explained[line-1] {
+					pass.Reportf(blank.Pos(), "ignored error should be explained with a comment")
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// explanationLines returns the lines in a file that hold part of a comment
+// explaining an ignored error.
+func explanationLines(fset *token.FileSet, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			if !isExplanation(c.Text) {
+				continue
+			}
+
+			for line := fset.Position(c.Pos()).Line; line <= fset.Position(c.End()).Line; line++ {
+				lines[line] = true
+			}
+		}
+	}
+
+	return lines
+}
+
+// isExplanation checks if a comment starts with "ignore", in any case, or
+// is a nolint directive.
+func isExplanation(text string) bool {
+	text = strings.TrimPrefix(text, "//")
+	text = strings.TrimPrefix(text, "/*")
+	text = strings.ToLower(strings.TrimSpace(text))
+
+	return strings.HasPrefix(text, "ignore") || strings.HasPrefix(text, "nolint")
+}
+
+// blankErrors returns the blank identifiers in an assignment that receive an error.
+func blankErrors(pass *analysis.Pass, assign *ast.AssignStmt) []*ast.Ident {
+	var blanks []*ast.Ident
+
+	for i, lhs := range assign.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok || ident.Name != "_" {
+			continue
+		}
+
+		if isError(resultType(pass, assign, i)) {
+			blanks = append(blanks, ident)
+		}
+	}
+
+	return blanks
+}
+
+// resultType returns the type of the value assigned to the ith left-hand
+// side of an assignment, or nil if it is not known.
+func resultType(pass *analysis.Pass, assign *ast.AssignStmt, i int) types.Type {
+	if len(assign.Lhs) == len(assign.Rhs) {
+		return pass.TypesInfo.TypeOf(assign.Rhs[i])
+	}
+
+	if len(assign.Rhs) != 1 {
+		return nil
+	}
+
+	tuple, ok := pass.TypesInfo.TypeOf(assign.Rhs[0]).(*types.Tuple)
+	if !ok || i >= tuple.Len() {
+		return nil
+	}
+
+	return tuple.At(i).Type()
+}
+
+// isError checks if a type is the error interface.
+func isError(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,47 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ignorederror
+
+import (
+	"io"
+	"os"
+	"strconv"
+)
+
+func examples(c io.Closer, err error) error {
+	_ = c.Close() // want `ignored error should be explained with a comment`
+
+	_, _ = strconv.Atoi("1") // want `ignored error should be explained with a comment`
+
+	n, _ := strconv.Atoi("2") // want `ignored error should be explained with a comment`
+	_ = n
+
+	_ = err // want `ignored error should be explained with a comment`
+
+	// Ignore error because the file is only read for its size hint.
+	_, _ = os.Stat("hint")
+
+	_ = c.Close() // Ignore error because the connection is already broken.
+
+	/*
+		Ignore error because the value defaults to zero.
+	*/
+	v, _ := strconv.Atoi("3")
+	_ = v
+
+	// Good: discarding values that are not errors.
+	_ = strconv.Itoa(4)
+
+	// Bad: a comment that does not explain the ignored error.
+	// Convert the port.
+	p, _ := strconv.Atoi("8080") // want `ignored error should be explained with a comment`
+	_ = p
+
+	_ = c.Close() //nolint:errcheck
+
+	// Good: the error is kept.
+	_, err = strconv.Atoi("5")
+
+	return err
+}
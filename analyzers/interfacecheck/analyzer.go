@@ -15,9 +15,12 @@
 package interfacecheck
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"path/filepath"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -50,16 +53,66 @@ Example:
     }`
 )
 
-// Analyzer is the interface check analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Analyzer is the interface check analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the interface check analyzer.
+type Option func(*runner)
+
+// WithLocalModulePrefix sets the module prefix of the local organisation.
+// Interfaces declared in imported packages under this prefix are checked in
+// addition to those declared in the package itself.
+func WithLocalModulePrefix(prefix string) Option {
+	return func(r *runner) {
+		r.localModulePrefix = prefix
+	}
+}
+
+// WithTargetFile sets the base name of a file, such as "compliance_checks.go",
+// to which suggested fixes append compliance checks instead of placing them
+// after the struct declaration. The file must already exist in the package.
+func WithTargetFile(name string) Option {
+	return func(r *runner) {
+		r.targetFile = name
+	}
+}
+
+// NewAnalyzer creates a new interface check analyzer with the given options.
+// Diagnostics carry a suggested fix that adds the missing compliance check.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
 }
 
-func run(pass *analysis.Pass) (any, error) {
+type runner struct {
+	localModulePrefix string
+	targetFile        string
+}
+
+// candidateInterface is an interface that structs in the package may implement.
+type candidateInterface struct {
+	obj   *types.TypeName
+	iface *types.Interface
+}
+
+// checkKey identifies a compliance check by its interface and struct types.
+type checkKey struct {
+	iface types.Object
+	impl  types.Object
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
 	// Collect all interfaces and structs defined in this package.
-	interfaces := make(map[string]*types.Interface)
+	var interfaces []candidateInterface
+
 	structs := make(map[string]*types.Struct)
 
 	for _, name := range pass.Pkg.Scope().Names() {
@@ -76,13 +129,15 @@ func run(pass *analysis.Pass) (any, error) {
 		switch t := typeName.Type().Underlying().(type) {
 		case *types.Interface:
 			if t.NumMethods() > 0 { // Skip empty interfaces.
-				interfaces[name] = t
+				interfaces = append(interfaces, candidateInterface{obj: typeName, iface: t})
 			}
 		case *types.Struct:
 			structs[name] = t
 		}
 	}
 
+	interfaces = append(interfaces, r.localImportedInterfaces(pass)...)
+
 	// Collect existing interface checks (var _ Interface = (*Struct)(nil)).
 	existingChecks := collectExistingChecks(pass)
 
@@ -96,37 +151,137 @@ func run(pass *analysis.Pass) (any, error) {
 		structType := structObj.Type()
 		ptrType := types.NewPointer(structType)
 
-		for ifaceName, iface := range interfaces {
+		for _, candidate := range interfaces {
 			// Check if the struct (or pointer to struct) implements the interface.
-			if !types.Implements(structType, iface) && !types.Implements(ptrType, iface) {
+			if !types.Implements(structType, candidate.iface) && !types.Implements(ptrType, candidate.iface) {
 				continue
 			}
 
 			// Check if there's already a compliance check.
-			key := ifaceName + ":" + structName
-
-			if existingChecks[key] {
+			if existingChecks[checkKey{iface: candidate.obj, impl: structObj}] {
 				continue
 			}
 
 			// Find the struct definition to report the diagnostic.
-			pos := findStructPos(pass, structName)
-			if pos == token.NoPos {
+			file, genDecl, typeSpec := findStructDecl(pass, structName)
+			if typeSpec == nil {
 				continue
 			}
 
-			pass.Reportf(pos,
-				"struct %q implements interface %q; consider adding: var _ %s = (*%s)(nil)",
-				structName, ifaceName, ifaceName, structName)
+			ifaceName, _ := qualifiedName(pass, file, candidate.obj)
+			check := complianceCheck(ifaceName, structName)
+
+			pass.Report(analysis.Diagnostic{
+				Pos: typeSpec.Name.Pos(),
+				Message: fmt.Sprintf("struct %q implements interface %q; consider adding: %s",
+					structName, ifaceName, check),
+				SuggestedFixes: []analysis.SuggestedFix{r.checkFix(pass, file, genDecl, candidate.obj, structName)},
+			})
 		}
 	}
 
 	return nil, nil
 }
 
+// localImportedInterfaces returns the exported non-empty interfaces declared in
+// imported packages under the local module prefix.
+func (r *runner) localImportedInterfaces(pass *analysis.Pass) []candidateInterface {
+	if r.localModulePrefix == "" {
+		return nil
+	}
+
+	var interfaces []candidateInterface
+
+	for _, pkg := range pass.Pkg.Imports() {
+		path := pkg.Path()
+		if path != r.localModulePrefix && !strings.HasPrefix(path, r.localModulePrefix+"/") {
+			continue
+		}
+
+		for _, name := range pkg.Scope().Names() {
+			typeName, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok || !typeName.Exported() {
+				continue
+			}
+
+			if t, ok := typeName.Type().Underlying().(*types.Interface); ok && t.NumMethods() > 0 {
+				interfaces = append(interfaces, candidateInterface{obj: typeName, iface: t})
+			}
+		}
+	}
+
+	return interfaces
+}
+
+// complianceCheck returns the declaration checking that a struct implements an interface.
+func complianceCheck(ifaceName string, structName string) string {
+	return fmt.Sprintf("var _ %s = (*%s)(nil)", ifaceName, structName)
+}
+
+// qualifiedName returns the name of an interface as written in a file,
+// qualified by the name under which the file imports its package, and
+// whether the file imports it. A file that does not import the package gets
+// the package's own name.
+func qualifiedName(pass *analysis.Pass, file *ast.File, obj *types.TypeName) (string, bool) {
+	if obj.Pkg() == pass.Pkg {
+		return obj.Name(), true
+	}
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || path != obj.Pkg().Path() {
+			continue
+		}
+
+		if spec.Name == nil {
+			return obj.Pkg().Name() + "." + obj.Name(), true
+		}
+
+		switch spec.Name.Name {
+		case "_":
+			continue
+		case ".":
+			return obj.Name(), true
+		default:
+			return spec.Name.Name + "." + obj.Name(), true
+		}
+	}
+
+	return obj.Pkg().Name() + "." + obj.Name(), false
+}
+
+// importEdits returns the edits adding an import of a package to a file: to
+// the end of its first parenthesized import declaration, or in a new
+// declaration after the existing ones or the package clause.
+func importEdits(file *ast.File, pkg *types.Package) []analysis.TextEdit {
+	spec := strconv.Quote(pkg.Path())
+	pos := file.Name.End()
+	text := "\n\nimport " + spec
+
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+
+		if genDecl.Lparen.IsValid() {
+			return []analysis.TextEdit{{
+				Pos:     genDecl.Rparen,
+				End:     genDecl.Rparen,
+				NewText: []byte("\t" + spec + "\n"),
+			}}
+		}
+
+		pos = genDecl.End()
+		text = "\nimport " + spec
+	}
+
+	return []analysis.TextEdit{{Pos: pos, End: pos, NewText: []byte(text)}}
+}
+
 // collectExistingChecks finds all var _ Interface = (*Struct)(nil) patterns.
-func collectExistingChecks(pass *analysis.Pass) map[string]bool {
-	checks := make(map[string]bool)
+func collectExistingChecks(pass *analysis.Pass) map[checkKey]bool {
+	checks := make(map[checkKey]bool)
 
 	for _, file := range pass.Files {
 		for _, decl := range file.Decls {
@@ -146,20 +301,24 @@ func collectExistingChecks(pass *analysis.Pass) map[string]bool {
 					continue
 				}
 
-				// Get the interface name from the type.
-				ifaceName := getInterfaceName(valueSpec.Type)
-				if ifaceName == "" {
+				// Get the interface from the type.
+				iface := namedObject(pass.TypesInfo.TypeOf(valueSpec.Type))
+				if iface == nil {
 					continue
 				}
 
-				// Get the struct name from the value.
-				structName := getStructNameFromNilCast(valueSpec)
-				if structName == "" {
+				// Get the struct from the value.
+				structExpr := getStructFromNilCast(valueSpec)
+				if structExpr == nil {
 					continue
 				}
 
-				key := ifaceName + ":" + structName
-				checks[key] = true
+				impl := namedObject(pass.TypesInfo.TypeOf(structExpr))
+				if impl == nil {
+					continue
+				}
+
+				checks[checkKey{iface: iface, impl: impl}] = true
 			}
 		}
 	}
@@ -167,63 +326,54 @@ func collectExistingChecks(pass *analysis.Pass) map[string]bool {
 	return checks
 }
 
-// getInterfaceName extracts the interface name from a type expression.
-func getInterfaceName(expr ast.Expr) string {
-	switch t := expr.(type) {
-	case *ast.Ident:
-		return t.Name
-	case *ast.SelectorExpr:
-		return t.Sel.Name
+// namedObject returns the declared type name of a named type, or nil.
+func namedObject(t types.Type) types.Object {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil
 	}
 
-	return ""
+	return named.Origin().Obj()
 }
 
-// getStructNameFromNilCast extracts the struct name from (*Struct)(nil) pattern.
-func getStructNameFromNilCast(vs *ast.ValueSpec) string {
+// getStructFromNilCast extracts the struct type from (*Struct)(nil) pattern.
+func getStructFromNilCast(vs *ast.ValueSpec) ast.Expr {
 	if len(vs.Values) != 1 {
-		return ""
+		return nil
 	}
 
 	// Expect (*Type)(nil).
 	call, ok := vs.Values[0].(*ast.CallExpr)
 	if !ok {
-		return ""
+		return nil
 	}
 
 	// Check for nil argument.
 	if len(call.Args) != 1 {
-		return ""
+		return nil
 	}
 
 	ident, ok := call.Args[0].(*ast.Ident)
 	if !ok || ident.Name != "nil" {
-		return ""
+		return nil
 	}
 
 	// Get the type from (*Type).
 	paren, ok := call.Fun.(*ast.ParenExpr)
 	if !ok {
-		return ""
+		return nil
 	}
 
 	star, ok := paren.X.(*ast.StarExpr)
 	if !ok {
-		return ""
-	}
-
-	switch x := star.X.(type) {
-	case *ast.Ident:
-		return x.Name
-	case *ast.SelectorExpr:
-		return x.Sel.Name
+		return nil
 	}
 
-	return ""
+	return star.X
 }
 
-// findStructPos finds the position of a struct type definition.
-func findStructPos(pass *analysis.Pass, name string) token.Pos {
+// findStructDecl finds the declaration of a struct type and the file declaring it.
+func findStructDecl(pass *analysis.Pass, name string) (*ast.File, *ast.GenDecl, *ast.TypeSpec) {
 	for _, file := range pass.Files {
 		for _, decl := range file.Decls {
 			genDecl, ok := decl.(*ast.GenDecl)
@@ -238,14 +388,74 @@ func findStructPos(pass *analysis.Pass, name string) token.Pos {
 				}
 
 				if typeSpec.Name.Name == name {
-					return typeSpec.Name.Pos()
+					return file, genDecl, typeSpec
 				}
 			}
 		}
 	}
 
-	return token.NoPos
+	return nil, nil, nil
 }
 
-// Ensure strings is used.
-var _ = strings.Contains
+// checkFix returns a fix adding the compliance check, appended to the target
+// file if one is configured and present in the package, otherwise placed
+// after the struct declaration. The fix imports the interface's package into
+// the file receiving the check if it does not already.
+func (r *runner) checkFix(pass *analysis.Pass, file *ast.File, genDecl *ast.GenDecl, iface *types.TypeName, structName string) analysis.SuggestedFix {
+	if target := r.findTargetFile(pass); target != nil {
+		ifaceName, imported := qualifiedName(pass, target, iface)
+
+		var edits []analysis.TextEdit
+		if !imported {
+			edits = importEdits(target, iface.Pkg())
+		}
+
+		return analysis.SuggestedFix{
+			Message: "Add compliance check to " + r.targetFile,
+			TextEdits: append(edits, analysis.TextEdit{
+				Pos:     target.FileEnd,
+				End:     target.FileEnd,
+				NewText: []byte("\n" + complianceCheck(ifaceName, structName) + "\n"),
+			}),
+		}
+	}
+
+	ifaceName, imported := qualifiedName(pass, file, iface)
+
+	var edits []analysis.TextEdit
+	if !imported {
+		edits = importEdits(file, iface.Pkg())
+	}
+
+	// Insert at the end of the line so any trailing comment stays with the declaration.
+	pos := genDecl.End()
+	if tokFile := pass.Fset.File(pos); tokFile != nil {
+		if line := tokFile.Line(pos); line < tokFile.LineCount() {
+			pos = tokFile.LineStart(line+1) - 1
+		}
+	}
+
+	return analysis.SuggestedFix{
+		Message: "Add compliance check",
+		TextEdits: append(edits, analysis.TextEdit{
+			Pos:     pos,
+			End:     pos,
+			NewText: []byte("\n\n" + complianceCheck(ifaceName, structName)),
+		}),
+	}
+}
+
+// findTargetFile returns the configured target file if it is part of the package.
+func (r *runner) findTargetFile(pass *analysis.Pass) *ast.File {
+	if r.targetFile == "" {
+		return nil
+	}
+
+	for _, file := range pass.Files {
+		if filepath.Base(pass.Fset.Position(file.Package).Filename) == r.targetFile {
+			return file
+		}
+	}
+
+	return nil
+}
@@ -25,3 +25,48 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, interfacecheck.Analyzer, "interfacecheck")
 }
+
+func TestAnalyzerLocalModulePrefix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := interfacecheck.NewAnalyzer(interfacecheck.WithLocalModulePrefix("example.com/org"))
+	analysistest.Run(t, testdata, analyzer, "interfacecheckorg", "interfacecheckqualified")
+}
+
+func TestAnalyzerInlineFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, interfacecheck.Analyzer, "interfacecheckfix")
+}
+
+func TestAnalyzerTargetFileFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := interfacecheck.NewAnalyzer(interfacecheck.WithTargetFile("compliance_checks.go"))
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "interfacecheckfile")
+}
+
+func TestAnalyzerTargetFileImportFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := interfacecheck.NewAnalyzer(
+		interfacecheck.WithLocalModulePrefix("example.com/org"),
+		interfacecheck.WithTargetFile("compliance_checks.go"),
+	)
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "interfacecheckfileimport")
+}
+
+func TestAnalyzerInlineImportFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := interfacecheck.NewAnalyzer(interfacecheck.WithLocalModulePrefix("example.com/org"))
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "interfacecheckfiximport")
+}
+
+func TestAnalyzerMissingTargetFileFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	// Fixes cannot create files, so a missing target falls back to inline checks.
+	analyzer := interfacecheck.NewAnalyzer(interfacecheck.WithTargetFile("compliance_checks.go"))
+	analysistest.RunWithSuggestedFixes(t, testdata, analyzer, "interfacecheckfix")
+}
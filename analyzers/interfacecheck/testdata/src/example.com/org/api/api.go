@@ -0,0 +1,19 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package api
+
+// Service is implemented by services in other packages of the organisation.
+type Service interface {
+	Name() string
+}
+
+// Provider is implemented by providers in other packages of the organisation.
+type Provider interface {
+	Provide() error
+}
+
+// internal is not exported so is never suggested.
+type internal interface {
+	Name() string
+}
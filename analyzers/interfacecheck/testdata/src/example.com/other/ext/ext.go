@@ -0,0 +1,9 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ext
+
+// Named is outside the local module prefix so is never suggested.
+type Named interface {
+	Name() string
+}
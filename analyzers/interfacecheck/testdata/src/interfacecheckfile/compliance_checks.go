@@ -0,0 +1,6 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfile
+
+// Compliance checks for the package.
@@ -0,0 +1,15 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfileimport
+
+import "example.com/org/api"
+
+var _ api.Provider = (*Worker)(nil)
+
+// Worker has its check added to a target file that does not import api.
+type Worker struct{} // want `struct "Worker" implements interface "api.Service"`
+
+func (w *Worker) Name() string { return "worker" }
+
+func (w *Worker) Provide() error { return nil }
@@ -0,0 +1,16 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfix
+
+// Writer is an interface for writing.
+type Writer interface {
+	Write(p []byte) (n int, err error)
+}
+
+// BadWriter has its check added after the declaration.
+type BadWriter struct{} // want `struct "BadWriter" implements interface "Writer"`
+
+func (w *BadWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
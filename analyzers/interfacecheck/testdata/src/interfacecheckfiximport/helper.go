@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfiximport
+
+import (
+	"strings"
+)
+
+// Helper is declared in a file whose import block lacks api.
+type Helper struct{} // want `struct "Helper" implements interface "api.Service"`
+
+func (h *Helper) Name() string { return strings.ToLower("Helper") }
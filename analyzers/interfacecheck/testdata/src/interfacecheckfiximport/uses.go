@@ -0,0 +1,15 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfiximport
+
+import (
+	"fmt"
+
+	"example.com/org/api"
+)
+
+// Describe describes a service.
+func Describe(s api.Service) string {
+	return fmt.Sprint(s.Name())
+}
@@ -0,0 +1,9 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckfiximport
+
+// Worker is declared in a file that does not import api.
+type Worker struct{} // want `struct "Worker" implements interface "api.Service"`
+
+func (w *Worker) Name() string { return "worker" }
@@ -0,0 +1,28 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckorg
+
+import (
+	"example.com/org/api"
+	"example.com/other/ext"
+)
+
+var _ ext.Named = (*Checked)(nil)
+
+// Bad: implements an organisation interface without a check.
+type Unchecked struct{} // want `struct "Unchecked" implements interface "api.Service"; consider adding: var _ api.Service = \(\*Unchecked\)\(nil\)`
+
+func (u *Unchecked) Name() string { return "unchecked" }
+
+// Good: has a compliance check.
+type Checked struct{}
+
+var _ api.Service = (*Checked)(nil)
+
+func (c *Checked) Name() string { return "checked" }
+
+// Good: implements nothing from the organisation.
+type Other struct{}
+
+func (o *Other) Run() {}
@@ -0,0 +1,35 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfacecheckqualified
+
+import orgapi "example.com/org/api"
+
+// Service shares its name with api.Service.
+type Service interface {
+	Name() string
+}
+
+// Bad: the check for the local Service does not cover api.Service.
+type Local struct{} // want `struct "Local" implements interface "orgapi.Service"; consider adding: var _ orgapi.Service = \(\*Local\)\(nil\)`
+
+var _ Service = (*Local)(nil)
+
+func (l *Local) Name() string { return "local" }
+
+// Bad: the check for api.Service does not cover the local Service.
+type Remote struct{} // want `struct "Remote" implements interface "Service"; consider adding: var _ Service = \(\*Remote\)\(nil\)`
+
+var _ orgapi.Service = (*Remote)(nil)
+
+func (r *Remote) Name() string { return "remote" }
+
+// Good: has both checks.
+type Both struct{}
+
+var (
+	_ Service        = (*Both)(nil)
+	_ orgapi.Service = (*Both)(nil)
+)
+
+func (b *Both) Name() string { return "both" }
@@ -0,0 +1,176 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interfaceplacement provides an analyzer that detects interfaces declared on the producer side.
+package interfaceplacement
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_interface_placement"
+	doc          = `detects exported interfaces declared next to their only implementation
+
+Go interfaces are best defined by the package that consumes them, listing
+only the methods it needs. An exported interface declared in the same
+package as its only implementation, and not used by that package as a
+parameter or field type, is likely there for the benefit of other packages
+and belongs with them.
+
+This is a heuristic: only implementations in the same package are counted.
+
+Bad:
+    package storage
+
+    type Storer interface {
+        Store(key string, value []byte) error
+    }
+
+    type DiskStore struct{}
+
+    func (d *DiskStore) Store(key string, value []byte) error { ... }
+
+Good:
+    package storage
+
+    type DiskStore struct{}
+
+    func (d *DiskStore) Store(key string, value []byte) error { ... }
+
+    package service
+
+    type storer interface {
+        Store(key string, value []byte) error
+    }`
+)
+
+// Analyzer is the interface placement analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	used := locallyUsedTypes(pass)
+	scope := pass.Pkg.Scope()
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !typeSpec.Name.IsExported() || typeSpec.TypeParams != nil {
+					continue
+				}
+
+				obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+				if !ok || obj.IsAlias() || used[obj] {
+					continue
+				}
+
+				iface, ok := obj.Type().Underlying().(*types.Interface)
+				if !ok || iface.NumMethods() == 0 || !iface.IsMethodSet() {
+					continue
+				}
+
+				if countImplementations(scope, obj, iface) == 1 {
+					pass.Reportf(typeSpec.Name.Pos(),
+						"interface %q is defined next to its implementation and unused locally", obj.Name())
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// locallyUsedTypes returns the type names used in the package as the type
+// of a parameter, struct field or embedded interface.
+func locallyUsedTypes(pass *analysis.Pass) map[*types.TypeName]bool {
+	used := make(map[*types.TypeName]bool)
+
+	markType := func(expr ast.Expr) {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok {
+				if typeName, ok := pass.TypesInfo.Uses[ident].(*types.TypeName); ok {
+					used[typeName] = true
+				}
+			}
+
+			return true
+		})
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.FuncType:
+				for _, field := range node.Params.List {
+					markType(field.Type)
+				}
+			case *ast.StructType:
+				for _, field := range node.Fields.List {
+					markType(field.Type)
+				}
+			case *ast.InterfaceType:
+				// Only embedded interfaces; method signatures are FuncTypes.
+				for _, field := range node.Methods.List {
+					if len(field.Names) == 0 {
+						markType(field.Type)
+					}
+				}
+			}
+
+			return true
+		})
+	}
+
+	return used
+}
+
+// countImplementations counts the concrete named types in a package scope
+// that implement an interface, directly or through a pointer.
+func countImplementations(scope *types.Scope, ifaceObj *types.TypeName, iface *types.Interface) int {
+	count := 0
+
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName == ifaceObj || typeName.IsAlias() {
+			continue
+		}
+
+		named, ok := typeName.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 {
+			continue
+		}
+
+		if types.IsInterface(named) {
+			continue
+		}
+
+		if types.Implements(named, iface) || types.Implements(types.NewPointer(named), iface) {
+			count++
+		}
+	}
+
+	return count
+}
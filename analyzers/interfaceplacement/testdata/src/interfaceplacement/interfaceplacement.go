@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package interfaceplacement
+
+import "io"
+
+// Bad: the only implementation is in this package and the interface is
+// not used here.
+type Storer interface { // want `interface "Storer" is defined next to its implementation and unused locally`
+	Store(key string, value []byte) error
+}
+
+type DiskStore struct{}
+
+func (d *DiskStore) Store(key string, value []byte) error { return nil }
+
+// Bad: returning the interface is not a local use.
+type Fetcher interface { // want `interface "Fetcher" is defined next to its implementation and unused locally`
+	Fetch(key string) ([]byte, error)
+}
+
+type httpFetcher struct{}
+
+func (h httpFetcher) Fetch(key string) ([]byte, error) { return nil, nil }
+
+func NewFetcher() Fetcher {
+	return httpFetcher{}
+}
+
+// Good: used as a parameter type.
+type Signer interface {
+	Sign(data []byte) ([]byte, error)
+}
+
+type localSigner struct{}
+
+func (l *localSigner) Sign(data []byte) ([]byte, error) { return data, nil }
+
+func signAll(signer Signer, items [][]byte) {}
+
+// Good: used as a field type.
+type Clock interface {
+	Now() int64
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() int64 { return 0 }
+
+type Scheduler struct {
+	clock Clock
+}
+
+// Good: has several implementations.
+type Encoder interface {
+	Encode(v any) ([]byte, error)
+}
+
+type jsonEncoder struct{}
+
+func (jsonEncoder) Encode(v any) ([]byte, error) { return nil, nil }
+
+type yamlEncoder struct{}
+
+func (yamlEncoder) Encode(v any) ([]byte, error) { return nil, nil }
+
+// Good: no implementation in this package.
+type Publisher interface {
+	Publish(topic string) error
+}
+
+// Good: embedded in another interface.
+type Opener interface {
+	Open() error
+}
+
+type OpenCloser interface {
+	Opener
+	io.Closer
+}
+
+type file struct{}
+
+func (file) Open() error { return nil }
+
+// Good: unexported interfaces are not part of the API.
+type counter interface {
+	Count() int
+}
+
+type simpleCounter struct{}
+
+func (simpleCounter) Count() int { return 0 }
+
+// Good: constraint interfaces cannot be used as values.
+type Number interface {
+	~int | ~int64
+	Double() int
+}
+
+type myInt int
+
+func (m myInt) Double() int { return int(m) * 2 }
@@ -0,0 +1,171 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ioerror provides an analyzer that detects discarded errors from IO functions.
+package ioerror
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/attestantio/attgo-linter/internal/typepattern"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_io_error"
+	doc          = `detects discarded errors from IO functions in functions returning no error
+
+A function that reads files or talks to the network but returns no error
+hides failures from its callers. Calls to the configured IO functions
+whose error result is assigned to _ are reported when the enclosing
+function, or function literal, has no error result of its own.
+
+Bad:
+    func loadConfig() []byte {
+        data, _ := os.ReadFile("config.yml")
+        return data
+    }
+
+Good:
+    func loadConfig() ([]byte, error) {
+        data, err := os.ReadFile("config.yml")
+        if err != nil {
+            return nil, errors.Wrap(err, "failed to read config")
+        }
+        return data, nil
+    }`
+)
+
+// NewAnalyzer creates a new IO error analyzer.
+// functions lists the IO functions to check, as patterns such as
+// "os.ReadFile" or "net/http.Get" matched against the package path and
+// function name.
+func NewAnalyzer(functions []string) *analysis.Analyzer {
+	r := &runner{
+		functions: functions,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	functions []string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				r.checkBody(pass, fn.Type, fn.Body)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// checkBody reports discarded IO errors in a function body. Function
+// literals are checked separately, against their own results.
+func (r *runner) checkBody(pass *analysis.Pass, funcType *ast.FuncType, body *ast.BlockStmt) {
+	returnsErr := returnsError(pass, funcType)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			r.checkBody(pass, node.Type, node.Body)
+
+			return false
+		case *ast.AssignStmt:
+			if !returnsErr {
+				r.checkAssign(pass, node)
+			}
+		}
+
+		return true
+	})
+}
+
+// checkAssign reports an assignment that discards the error result of an
+// IO function call with _.
+func (r *runner) checkAssign(pass *analysis.Pass, assign *ast.AssignStmt) {
+	if len(assign.Rhs) != 1 {
+		return
+	}
+
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Signature().Recv() != nil {
+		return
+	}
+
+	name := fn.Pkg().Path() + "." + fn.Name()
+	if !matchAny(name, r.functions) {
+		return
+	}
+
+	results := fn.Signature().Results()
+	if results.Len() != len(assign.Lhs) {
+		return
+	}
+
+	for i := range results.Len() {
+		if !isErrorType(results.At(i).Type()) {
+			continue
+		}
+
+		if ident, ok := assign.Lhs[i].(*ast.Ident); ok && ident.Name == "_" {
+			pass.Reportf(ident.Pos(), "IO error from %q is discarded", fn.Pkg().Name()+"."+fn.Name())
+		}
+	}
+}
+
+// matchAny checks if a qualified function name matches any of the patterns.
+func matchAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if typepattern.Match(name, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// returnsError checks if a function type has an error result.
+func returnsError(pass *analysis.Pass, funcType *ast.FuncType) bool {
+	if funcType.Results == nil {
+		return false
+	}
+
+	for _, field := range funcType.Results.List {
+		if isErrorType(pass.TypesInfo.TypeOf(field.Type)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isErrorType checks if a type is the error interface.
+func isErrorType(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
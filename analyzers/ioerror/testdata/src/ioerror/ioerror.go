@@ -0,0 +1,82 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package ioerror
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// Bad: errors from IO functions are discarded in functions returning no error.
+func loadConfig() []byte {
+	data, _ := os.ReadFile("config.yml") // want `IO error from "os.ReadFile" is discarded`
+
+	return data
+}
+
+func fetch(url string) int {
+	resp, _ := http.Get(url) // want `IO error from "http.Get" is discarded`
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body) // want `IO error from "io.ReadAll" is discarded`
+
+	return len(body)
+}
+
+func reopen(f *os.File) {
+	var err error
+	f, _ = os.Open("data.bin") // want `IO error from "os.Open" is discarded`
+	_ = err
+}
+
+// Bad: function literals are checked against their own results.
+func watch() error {
+	go func() {
+		_, _ = os.ReadFile("state.json") // want `IO error from "os.ReadFile" is discarded`
+	}()
+
+	return nil
+}
+
+// Good: the enclosing function can return the error.
+func loadConfigChecked() ([]byte, error) {
+	data, _ := os.ReadFile("config.yml")
+
+	return data, nil
+}
+
+// Good: the error is handled.
+func loadOptional() []byte {
+	data, err := os.ReadFile("optional.yml")
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+// Good: a function literal returning an error.
+func retry() {
+	read := func() error {
+		_, _ = os.ReadFile("state.json")
+
+		return nil
+	}
+	_ = read
+}
+
+// Good: not an IO function.
+func parse(s string) int {
+	n, _ := strconv.Atoi(s)
+
+	return n
+}
+
+// Good: not in the configured list.
+func create() {
+	f, _ := os.Create("out.txt")
+	_ = f
+}
@@ -0,0 +1,192 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsontagrequired provides an analyzer that checks JSON-serialized structs have json tags.
+package jsontagrequired
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/attestantio/attgo-linter/internal/structtag"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_json_tag_required"
+	doc          = `checks exported fields of JSON-serialized structs have json tags
+
+Without a json tag, the wire name of a field is its Go name, so renaming
+the field silently changes the serialized format. Structs declared in the
+package and passed to encoding/json, directly or as the fields of such a
+struct, must tag every exported field.
+
+Bad:
+    type User struct {
+        UserName string
+    }
+
+    json.Marshal(&User{})
+
+Good:
+    type User struct {
+        UserName string ` + "`json:\"user_name\"`" + `
+    }`
+)
+
+// Analyzer is the JSON tag required analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// valueArgs maps encoding/json functions and methods to the index of
+// their argument holding the value being (un)marshalled.
+var valueArgs = map[string]int{
+	"encoding/json.Marshal":           0,
+	"encoding/json.MarshalIndent":     0,
+	"encoding/json.Unmarshal":         1,
+	"(*encoding/json.Encoder).Encode": 0,
+	"(*encoding/json.Decoder).Decode": 0,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// Collect the local struct declarations so fields can be reported.
+	structs := make(map[*types.TypeName]*ast.StructType)
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			if st, ok := typeSpec.Type.(*ast.StructType); ok {
+				if obj, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName); ok {
+					structs[obj] = st
+				}
+			}
+
+			return true
+		})
+	}
+
+	// Find the structs that reach encoding/json.
+	serialized := make(map[*types.TypeName]bool)
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+			if !ok {
+				return true
+			}
+
+			index, ok := valueArgs[fn.FullName()]
+			if !ok || index >= len(call.Args) {
+				return true
+			}
+
+			collectStructs(pass, pass.TypesInfo.TypeOf(call.Args[index]), serialized)
+
+			return true
+		})
+	}
+
+	for obj := range serialized {
+		st, ok := structs[obj]
+		if !ok {
+			continue
+		}
+
+		checkFields(pass, st)
+	}
+
+	return nil, nil
+}
+
+// collectStructs records the local named struct types reachable from t
+// through pointers, slices, arrays, maps and exported struct fields.
+func collectStructs(pass *analysis.Pass, t types.Type, serialized map[*types.TypeName]bool) {
+	switch typ := t.(type) {
+	case *types.Pointer:
+		collectStructs(pass, typ.Elem(), serialized)
+	case *types.Slice:
+		collectStructs(pass, typ.Elem(), serialized)
+	case *types.Array:
+		collectStructs(pass, typ.Elem(), serialized)
+	case *types.Map:
+		collectStructs(pass, typ.Elem(), serialized)
+	case *types.Named:
+		obj := typ.Obj()
+		if obj.Pkg() != pass.Pkg || serialized[obj] || hasCustomJSON(typ) {
+			return
+		}
+
+		st, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return
+		}
+
+		serialized[obj] = true
+
+		for field := range st.Fields() {
+			if field.Exported() || field.Embedded() {
+				collectStructs(pass, field.Type(), serialized)
+			}
+		}
+	}
+}
+
+// hasCustomJSON checks if a type controls its own encoding with a
+// MarshalJSON or UnmarshalJSON method.
+func hasCustomJSON(named *types.Named) bool {
+	methods := types.NewMethodSet(types.NewPointer(named))
+
+	for _, name := range []string{"MarshalJSON", "UnmarshalJSON"} {
+		if methods.Lookup(named.Obj().Pkg(), name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFields reports exported fields of a struct declaration without a json tag.
+// Embedded fields are inlined by encoding/json and are not reported.
+func checkFields(pass *analysis.Pass, st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		if hasJSONTag(field) {
+			continue
+		}
+
+		for _, name := range field.Names {
+			if name.IsExported() {
+				pass.Reportf(name.Pos(), "field %q should have a json tag for stable serialization", name.Name)
+			}
+		}
+	}
+}
+
+// hasJSONTag checks if a field has a json struct tag, including json:"-".
+func hasJSONTag(field *ast.Field) bool {
+	_, ok := structtag.Lookup(field, "json")
+
+	return ok
+}
@@ -0,0 +1,76 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package jsontagrequired
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Bad: marshalled without tags.
+type User struct {
+	UserName string // want `field "UserName" should have a json tag for stable serialization`
+	Email    string `json:"email"`
+	Address  *Address // want `field "Address" should have a json tag for stable serialization`
+	password string
+}
+
+// Bad: reached through a field of a marshalled struct.
+type Address struct {
+	Street, City string // want `field "Street" should have a json tag for stable serialization` `field "City" should have a json tag for stable serialization`
+}
+
+// Bad: unmarshalled through a slice.
+type Event struct {
+	Kind string // want `field "Kind" should have a json tag for stable serialization`
+	Skip string `json:"-"`
+}
+
+// Bad: decoded from a stream.
+type Request struct {
+	ID int // want `field "ID" should have a json tag for stable serialization`
+}
+
+// Good: tagged.
+type Response struct {
+	Status string `json:"status"`
+}
+
+// Good: custom encoding.
+type Version struct {
+	Major int
+}
+
+func (v Version) MarshalJSON() ([]byte, error) {
+	return []byte("1"), nil
+}
+
+// Good: never serialized.
+type Internal struct {
+	Name string
+}
+
+func encode(r io.Reader, w io.Writer) error {
+	if _, err := json.Marshal(&User{}); err != nil {
+		return err
+	}
+
+	var events []Event
+	if err := json.Unmarshal([]byte("[]"), &events); err != nil {
+		return err
+	}
+
+	var req Request
+	if err := json.NewDecoder(r).Decode(&req); err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(w).Encode(Response{}); err != nil {
+		return err
+	}
+
+	_, err := json.Marshal(Version{})
+
+	return err
+}
@@ -0,0 +1,151 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lifecyclemethod provides an analyzer that checks types starting goroutines can be stopped.
+package lifecyclemethod
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_lifecycle_method"
+	doc          = `checks types whose methods start goroutines have a Stop or Close method
+
+A method that launches a background goroutine on a type with no way to stop
+it or wait for it leaks the goroutine once the value is no longer needed.
+Each struct type with methods containing a go statement should have a
+Close, Stop or Shutdown method. Each type is reported once.
+
+Bad:
+    type Worker struct{}
+
+    func (w *Worker) Start() {
+        go w.loop()
+    }
+
+Good:
+    type Worker struct {
+        done chan struct{}
+    }
+
+    func (w *Worker) Start() {
+        go w.loop()
+    }
+
+    func (w *Worker) Stop() {
+        close(w.done)
+    }`
+)
+
+// Analyzer is the lifecycle method analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// stopMethods are the method names that let a caller stop a type's goroutines.
+var stopMethods = []string{"Close", "Stop", "Shutdown"}
+
+func run(pass *analysis.Pass) (any, error) {
+	// Types are collected in declaration order so diagnostics are stable.
+	var launchers []*types.Named
+
+	seen := make(map[*types.Named]bool)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Body == nil || !startsGoroutine(fn.Body) {
+				continue
+			}
+
+			named := receiverType(pass, fn)
+			if named == nil || seen[named] {
+				continue
+			}
+
+			seen[named] = true
+			launchers = append(launchers, named)
+		}
+	}
+
+	for _, named := range launchers {
+		if _, ok := named.Underlying().(*types.Struct); !ok || hasStopMethod(named) {
+			continue
+		}
+
+		pass.Reportf(named.Obj().Pos(), "type %q starts goroutines but has no Stop/Close method", named.Obj().Name())
+	}
+
+	return nil, nil
+}
+
+// startsGoroutine checks if a function body contains a go statement.
+func startsGoroutine(body *ast.BlockStmt) bool {
+	found := false
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if _, ok := n.(*ast.GoStmt); ok {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// receiverType returns the named type a method is declared on, or nil if
+// there is none.
+func receiverType(pass *analysis.Pass, fn *ast.FuncDecl) *types.Named {
+	method, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	recv := method.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return nil
+	}
+
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	return named.Origin()
+}
+
+// hasStopMethod checks if a type, or a pointer to it, has one of the stop
+// methods, including methods promoted from embedded fields.
+func hasStopMethod(named *types.Named) bool {
+	methods := types.NewMethodSet(types.NewPointer(named))
+
+	for _, name := range stopMethods {
+		if methods.Lookup(named.Obj().Pkg(), name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
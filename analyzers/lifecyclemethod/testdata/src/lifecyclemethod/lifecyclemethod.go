@@ -0,0 +1,100 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package lifecyclemethod
+
+import (
+	"io"
+)
+
+// Bad: starts goroutines in two methods but cannot be stopped.
+type Worker struct { // want `type "Worker" starts goroutines but has no Stop/Close method`
+	jobs chan int
+}
+
+func (w *Worker) Start() {
+	go w.loop()
+}
+
+func (w *Worker) Submit(job int) {
+	go func() {
+		w.jobs <- job
+	}()
+}
+
+func (w *Worker) loop() {
+	for range w.jobs {
+	}
+}
+
+// Good: has a Stop method.
+type Poller struct {
+	done chan struct{}
+}
+
+func (p *Poller) Start() {
+	go p.poll()
+}
+
+func (p *Poller) Stop() {
+	close(p.done)
+}
+
+func (p *Poller) poll() {
+	<-p.done
+}
+
+// Good: has a Close method on the value receiver.
+type Stream struct {
+	done chan struct{}
+}
+
+func (s Stream) Open() {
+	go func() {
+		<-s.done
+	}()
+}
+
+func (s Stream) Close() error {
+	close(s.done)
+
+	return nil
+}
+
+// Good: has a Shutdown method with a context-style signature.
+type Server struct{}
+
+func (s *Server) Serve() {
+	go func() {}()
+}
+
+func (s *Server) Shutdown(timeout int) error {
+	return nil
+}
+
+// Good: Close is promoted from an embedded field.
+type Pipe struct {
+	io.Closer
+}
+
+func (p *Pipe) Pump() {
+	go func() {}()
+}
+
+// Good: no goroutines.
+type Counter struct {
+	n int
+}
+
+func (c *Counter) Inc() {
+	c.n++
+}
+
+// Bad: generic types are checked too.
+type Pool[T any] struct { // want `type "Pool" starts goroutines but has no Stop/Close method`
+	items []T
+}
+
+func (p *Pool[T]) Fill() {
+	go func() {}()
+}
@@ -0,0 +1,206 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lostcancel provides an analyzer that detects context cancel functions that are never called.
+// Until its cancel function is called, a derived context and its resources are only released when the parent is cancelled.
+package lostcancel
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_lost_cancel"
+	doc          = `detects cancel functions from context.WithCancel, WithTimeout and WithDeadline that are never used
+
+The cancel function returned when deriving a context releases the
+resources associated with it, such as its timer and its registration with
+the parent context. If it is never called they are held until the parent
+is cancelled, which for a long-lived parent may be never.
+
+A cancel function assigned with := is reported if its only uses are
+assignments to the blank identifier, as in _ = cancel. Calling it,
+deferring it, returning it, passing it on or storing it all count as
+handling it. A fix inserts defer cancel() after the assignment. Cancel
+functions assigned to the blank identifier directly are always reported.
+
+Bad:
+    ctx, cancel := context.WithTimeout(ctx, time.Second)
+    return s.client.Get(ctx, "/data")
+
+Good:
+    ctx, cancel := context.WithTimeout(ctx, time.Second)
+    defer cancel()
+    return s.client.Get(ctx, "/data")`
+)
+
+// Analyzer is the lost-cancel analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// cancelFuncs are the context functions that return a cancel function as
+// their second result.
+var cancelFuncs = map[string]bool{
+	"WithCancel":   true,
+	"WithTimeout":  true,
+	"WithDeadline": true,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	discarded := discardedIdents(pass)
+
+	used := make(map[types.Object]bool)
+	for ident, obj := range pass.TypesInfo.Uses {
+		if !discarded[ident] {
+			used[obj] = true
+		}
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.BlockStmt:
+				checkStmts(pass, node.List, used)
+			case *ast.CaseClause:
+				checkStmts(pass, node.Body, used)
+			case *ast.CommClause:
+				checkStmts(pass, node.Body, used)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// discardedIdents returns the identifiers assigned to the blank identifier,
+// as in _ = cancel, which only silence the compiler's unused variable error.
+func discardedIdents(pass *analysis.Pass) map[*ast.Ident]bool {
+	discarded := make(map[*ast.Ident]bool)
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+
+			for i, lhs := range assign.Lhs {
+				blank, ok := lhs.(*ast.Ident)
+				if !ok || blank.Name != "_" {
+					continue
+				}
+
+				if ident, ok := assign.Rhs[i].(*ast.Ident); ok {
+					discarded[ident] = true
+				}
+			}
+
+			return true
+		})
+	}
+
+	return discarded
+}
+
+// checkStmts reports the cancel functions defined by a list of statements
+// that are never called or handed on.
+func checkStmts(pass *analysis.Pass, stmts []ast.Stmt, used map[types.Object]bool) {
+	for _, stmt := range stmts {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 2 || len(assign.Rhs) != 1 {
+			continue
+		}
+
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		funcName, ok := cancelFuncName(pass, call)
+		if !ok {
+			continue
+		}
+
+		ident, ok := assign.Lhs[1].(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if ident.Name == "_" {
+			pass.Reportf(ident.Pos(), "cancel function returned by context.%s is discarded", funcName)
+
+			continue
+		}
+
+		// A cancel function assigned with = or redeclared by := was defined
+		// by an earlier statement, which may already handle it.
+		obj := pass.TypesInfo.Defs[ident]
+		if assign.Tok != token.DEFINE || obj == nil || used[obj] {
+			continue
+		}
+
+		reportLost(pass, assign, ident, funcName)
+	}
+}
+
+// cancelFuncName returns the name of the context function that a call
+// derives a cancellable context with, such as "WithTimeout".
+func cancelFuncName(pass *analysis.Pass, call *ast.CallExpr) (string, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "context" || !cancelFuncs[fn.Name()] {
+		return "", false
+	}
+
+	return fn.Name(), true
+}
+
+// reportLost reports a cancel function that is never called, with a fix
+// deferring it on the line after the assignment.
+func reportLost(pass *analysis.Pass, assign *ast.AssignStmt, ident *ast.Ident, funcName string) {
+	diag := analysis.Diagnostic{
+		Pos:     ident.Pos(),
+		End:     ident.End(),
+		Message: fmt.Sprintf("cancel function %q returned by context.%s is never called", ident.Name, funcName),
+	}
+
+	// The defer goes on the next line so that it follows any trailing comment.
+	file := pass.Fset.File(assign.End())
+	line := file.Line(assign.End())
+	if line < file.LineCount() {
+		indent := strings.Repeat("\t", pass.Fset.Position(assign.Pos()).Column-1)
+		next := file.LineStart(line + 1)
+
+		diag.SuggestedFixes = []analysis.SuggestedFix{{
+			Message: "Defer " + ident.Name + "()",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     next,
+				End:     next,
+				NewText: []byte(indent + "defer " + ident.Name + "()\n"),
+			}},
+		}}
+	}
+
+	pass.Report(diag)
+}
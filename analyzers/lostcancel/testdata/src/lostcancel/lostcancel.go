@@ -0,0 +1,123 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package lostcancel
+
+import (
+	"context"
+	"time"
+)
+
+func fetch(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Bad: cancel is never called.
+func badTimeout(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second) // want `cancel function "cancel" returned by context.WithTimeout is never called`
+	_ = cancel
+
+	return fetch(ctx)
+}
+
+// Bad: cancel from WithCancel is never called.
+func badCancel(ctx context.Context) error {
+	child, stop := context.WithCancel(ctx) // want `cancel function "stop" returned by context.WithCancel is never called`
+	_ = stop
+	return fetch(child)
+}
+
+// Bad: cancel from WithDeadline in a nested block.
+func badDeadline(ctx context.Context, deadline time.Time, retry bool) error {
+	if retry {
+		ctx, cancel := context.WithDeadline(ctx, deadline) // want `cancel function "cancel" returned by context.WithDeadline is never called`
+		_ = cancel
+
+		return fetch(ctx)
+	}
+
+	return nil
+}
+
+// Bad: cancel inside a function literal.
+func badLiteral(ctx context.Context) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(ctx, time.Second) // want `cancel function "cancel" returned by context.WithTimeout is never called`
+		_ = cancel
+
+		return fetch(ctx)
+	}
+}
+
+// Bad: cancel inside a switch case.
+func badCase(ctx context.Context, mode int) error {
+	switch mode {
+	case 1:
+		ctx, cancel := context.WithCancel(ctx) // want `cancel function "cancel" returned by context.WithCancel is never called`
+		_ = cancel
+
+		return fetch(ctx)
+	}
+
+	return nil
+}
+
+// Bad: cancel discarded.
+func badBlank(ctx context.Context) error {
+	ctx, _ = context.WithTimeout(ctx, time.Second) // want `cancel function returned by context.WithTimeout is discarded`
+
+	return fetch(ctx)
+}
+
+// Good: cancel deferred.
+func goodDefer(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	return fetch(ctx)
+}
+
+// Good: cancel called explicitly.
+func goodCall(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	err := fetch(ctx)
+	cancel()
+
+	return err
+}
+
+// Good: cancel returned to the caller.
+func goodReturn(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return ctx, cancel
+}
+
+// Good: cancel called in a goroutine.
+func goodGoroutine(ctx context.Context, done <-chan struct{}) context.Context {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		<-done
+		cancel()
+	}()
+
+	return ctx
+}
+
+// Good: cancel passed on.
+func goodPassed(ctx context.Context, register func(context.CancelFunc)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	register(cancel)
+
+	return fetch(ctx)
+}
+
+// Good: not a context function.
+func goodOther() {
+	v, ok := lookup()
+	_, _ = v, ok
+}
+
+func lookup() (int, bool) {
+	return 0, false
+}
@@ -0,0 +1,316 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mapinit provides an analyzer that checks how constructors initialise map and slice fields.
+package mapinit
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_map_init"
+	doc          = `checks how constructors initialise map and slice fields
+
+Teams differ on whether constructors should initialise map and slice
+fields up front. This analyzer enforces one of two modes:
+
+require-explicit (default): every map and slice field of the constructed
+struct must be initialised in its New... constructor, so that writes never
+hit a nil map.
+
+forbid-eager: constructors must not initialise map and slice fields to empty
+values (make(...), map[K]V{}, []T{}); the zero value is used until the field
+is first needed.
+
+Bad (require-explicit):
+    func NewCache() *Cache {
+        return &Cache{}
+    }
+
+Good (require-explicit):
+    func NewCache() *Cache {
+        return &Cache{items: make(map[string]Item)}
+    }`
+)
+
+// Mode is the map initialisation mode enforced by the analyzer.
+type Mode uint64
+
+const (
+	// ModeUnknown is an unrecognised mode.
+	ModeUnknown Mode = iota
+	// ModeRequireExplicit requires constructors to initialise map and slice fields.
+	ModeRequireExplicit
+	// ModeForbidEager forbids constructors from initialising map and slice fields to empty values.
+	ModeForbidEager
+)
+
+var modeStrings = [...]string{
+	"unknown",
+	"require-explicit",
+	"forbid-eager",
+}
+
+// String returns the configuration name of the mode.
+func (m Mode) String() string {
+	if int(m) >= len(modeStrings) {
+		return modeStrings[ModeUnknown]
+	}
+
+	return modeStrings[m]
+}
+
+// ParseMode parses a mode from its configuration name.
+func ParseMode(name string) (Mode, error) {
+	for i, str := range modeStrings {
+		if i != int(ModeUnknown) && str == name {
+			return Mode(i), nil
+		}
+	}
+
+	return ModeUnknown, fmt.Errorf("unknown map init mode %q; must be one of require-explicit or forbid-eager", name)
+}
+
+// NewAnalyzer creates a new map-init analyzer enforcing the given mode.
+func NewAnalyzer(mode Mode) *analysis.Analyzer {
+	r := &runner{
+		mode: mode,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	mode Mode
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || funcDecl.Body == nil {
+				continue
+			}
+
+			if !strings.HasPrefix(funcDecl.Name.Name, "New") {
+				continue
+			}
+
+			named := constructedType(pass, funcDecl)
+			if named == nil {
+				continue
+			}
+
+			r.checkConstructor(pass, funcDecl, named)
+		}
+	}
+
+	return nil, nil
+}
+
+// constructedType returns the struct type returned by a constructor, or nil if
+// the function does not return a struct declared in the current package.
+func constructedType(pass *analysis.Pass, fn *ast.FuncDecl) *types.Named {
+	if fn.Type.Results == nil {
+		return nil
+	}
+
+	for _, result := range fn.Type.Results.List {
+		t := pass.TypesInfo.TypeOf(result.Type)
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem()
+		}
+
+		named, ok := t.(*types.Named)
+		if !ok || named.Obj().Pkg() != pass.Pkg {
+			continue
+		}
+
+		if _, isStruct := named.Underlying().(*types.Struct); isStruct {
+			return named
+		}
+	}
+
+	return nil
+}
+
+// fieldInit is the initialisation of a struct field within a constructor.
+type fieldInit struct {
+	field *types.Var
+	value ast.Expr
+}
+
+func (r *runner) checkConstructor(pass *analysis.Pass, fn *ast.FuncDecl, named *types.Named) {
+	inits := collectFieldInits(pass, fn.Body, named)
+
+	switch r.mode {
+	case ModeRequireExplicit:
+		initialised := make(map[*types.Var]bool, len(inits))
+		for _, init := range inits {
+			// Setting a field to nil leaves it uninitialised.
+			if pass.TypesInfo.Types[init.value].IsNil() {
+				continue
+			}
+
+			initialised[init.field] = true
+		}
+
+		st, _ := named.Underlying().(*types.Struct)
+		for i := range st.NumFields() {
+			field := st.Field(i)
+
+			kind := containerKind(field.Type())
+			if kind == "" || initialised[field] {
+				continue
+			}
+
+			pass.Reportf(fn.Name.Pos(), "%s field %q should be explicitly initialised in constructor %q",
+				kind, field.Name(), fn.Name.Name)
+		}
+	case ModeForbidEager:
+		for _, init := range inits {
+			kind := containerKind(init.field.Type())
+			if kind == "" || !isEmptyContainer(pass, init.value) {
+				continue
+			}
+
+			pass.Reportf(init.value.Pos(), "%s field %q should not be eagerly initialised in constructor %q",
+				kind, init.field.Name(), fn.Name.Name)
+		}
+	}
+}
+
+// collectFieldInits finds the fields of named that are set in body, either in
+// a composite literal of the type or by assignment to a selector.
+func collectFieldInits(pass *analysis.Pass, body *ast.BlockStmt, named *types.Named) []fieldInit {
+	st, _ := named.Underlying().(*types.Struct)
+
+	var inits []fieldInit
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CompositeLit:
+			if !types.Identical(pass.TypesInfo.TypeOf(node), named) {
+				return true
+			}
+
+			for i, elt := range node.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if field := fieldOf(pass, kv.Key); field != nil {
+						inits = append(inits, fieldInit{field: field, value: kv.Value})
+					}
+
+					continue
+				}
+
+				if i < st.NumFields() {
+					inits = append(inits, fieldInit{field: st.Field(i), value: elt})
+				}
+			}
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) {
+				return true
+			}
+
+			for i, lhs := range node.Lhs {
+				sel, ok := lhs.(*ast.SelectorExpr)
+				if !ok || !isNamedOrPointer(pass.TypesInfo.TypeOf(sel.X), named) {
+					continue
+				}
+
+				if field := fieldOf(pass, sel.Sel); field != nil {
+					inits = append(inits, fieldInit{field: field, value: node.Rhs[i]})
+				}
+			}
+		}
+
+		return true
+	})
+
+	return inits
+}
+
+// fieldOf returns the struct field referred to by an identifier, if any.
+func fieldOf(pass *analysis.Pass, expr ast.Expr) *types.Var {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	field, ok := pass.TypesInfo.ObjectOf(ident).(*types.Var)
+	if !ok || !field.IsField() {
+		return nil
+	}
+
+	return field
+}
+
+// isNamedOrPointer checks if t is named or a pointer to named.
+func isNamedOrPointer(t types.Type, named *types.Named) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	return t != nil && types.Identical(t, named)
+}
+
+// containerKind returns "map" or "slice" for map and slice types, or an empty string otherwise.
+func containerKind(t types.Type) string {
+	switch t.Underlying().(type) {
+	case *types.Map:
+		return "map"
+	case *types.Slice:
+		return "slice"
+	}
+
+	return ""
+}
+
+// isEmptyContainer checks if an expression creates an empty map or slice,
+// such as make(map[K]V), make([]T, 0), map[K]V{} or []T{}.
+func isEmptyContainer(pass *analysis.Pass, expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return len(e.Elts) == 0
+	case *ast.CallExpr:
+		ident, ok := e.Fun.(*ast.Ident)
+		if !ok {
+			return false
+		}
+
+		if _, isBuiltin := pass.TypesInfo.ObjectOf(ident).(*types.Builtin); !isBuiltin || ident.Name != "make" {
+			return false
+		}
+
+		// A slice made with a non-zero length is not empty.
+		if len(e.Args) >= 2 && containerKind(pass.TypesInfo.TypeOf(e.Args[0])) == "slice" {
+			tv, ok := pass.TypesInfo.Types[e.Args[1]]
+
+			return ok && tv.Value != nil && tv.Value.ExactString() == "0"
+		}
+
+		return true
+	}
+
+	return false
+}
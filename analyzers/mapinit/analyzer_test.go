@@ -0,0 +1,56 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mapinit_test
+
+import (
+	"testing"
+
+	"github.com/attestantio/attgo-linter/analyzers/mapinit"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzerRequireExplicit(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, mapinit.NewAnalyzer(mapinit.ModeRequireExplicit), "mapinitexplicit")
+}
+
+func TestAnalyzerForbidEager(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, mapinit.NewAnalyzer(mapinit.ModeForbidEager), "mapinitforbid")
+}
+
+func TestParseMode(t *testing.T) {
+	tests := map[string]mapinit.Mode{
+		"require-explicit": mapinit.ModeRequireExplicit,
+		"forbid-eager":     mapinit.ModeForbidEager,
+	}
+
+	for name, expected := range tests {
+		mode, err := mapinit.ParseMode(name)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if mode != expected {
+			t.Errorf("%s: got mode %s, want %s", name, mode, expected)
+		}
+	}
+
+	for _, name := range []string{"", "unknown", "eager"} {
+		if _, err := mapinit.ParseMode(name); err == nil {
+			t.Errorf("%q: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package mapinitexplicit
+
+// Cache has map and slice fields.
+type Cache struct {
+	name  string
+	items map[string]int
+	order []string
+}
+
+// Bad: neither container field is initialised.
+func NewCache() *Cache { // want `map field "items" should be explicitly initialised in constructor "NewCache"` `slice field "order" should be explicitly initialised in constructor "NewCache"`
+	return &Cache{name: "cache"}
+}
+
+// Bad: map field is missing.
+func NewPartialCache() *Cache { // want `map field "items" should be explicitly initialised in constructor "NewPartialCache"`
+	c := &Cache{}
+	c.order = make([]string, 0)
+
+	return c
+}
+
+// Good: composite literal initialises both fields.
+func NewFullCache() *Cache {
+	return &Cache{
+		items: make(map[string]int),
+		order: []string{},
+	}
+}
+
+// Good: assignments initialise both fields.
+func NewAssignedCache() Cache {
+	var c Cache
+	c.items = map[string]int{}
+	c.order = []string{}
+
+	return c
+}
+
+// Good: positional literal sets every field.
+func NewPositionalCache() *Cache {
+	return &Cache{"cache", map[string]int{}, []string{}}
+}
+
+// Bad: a nil value leaves the map uninitialised.
+func NewNilCache() *Cache { // want `map field "items" should be explicitly initialised in constructor "NewNilCache"`
+	return &Cache{items: nil, order: []string{}}
+}
+
+// Bad: assigning nil leaves the slice uninitialised.
+func NewNilAssignedCache() *Cache { // want `slice field "order" should be explicitly initialised in constructor "NewNilAssignedCache"`
+	c := &Cache{items: map[string]int{}}
+	c.order = nil
+
+	return c
+}
+
+// Bad: a positional nil leaves the map uninitialised.
+func NewNilPositionalCache() *Cache { // want `map field "items" should be explicitly initialised in constructor "NewNilPositionalCache"`
+	return &Cache{"cache", nil, []string{}}
+}
+
+// Good: not a constructor.
+func buildCache() *Cache {
+	return &Cache{}
+}
+
+// Plain has no container fields.
+type Plain struct {
+	name string
+}
+
+// Good: nothing to initialise.
+func NewPlain() *Plain {
+	return &Plain{}
+}
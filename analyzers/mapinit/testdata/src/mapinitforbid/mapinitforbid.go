@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package mapinitforbid
+
+// Cache has map and slice fields.
+type Cache struct {
+	name  string
+	items map[string]int
+	order []string
+}
+
+// Bad: eager empty initialisation.
+func NewCache() *Cache {
+	return &Cache{
+		items: make(map[string]int), // want `map field "items" should not be eagerly initialised in constructor "NewCache"`
+		order: []string{},           // want `slice field "order" should not be eagerly initialised in constructor "NewCache"`
+	}
+}
+
+// Bad: eager initialisation by assignment.
+func NewAssignedCache() *Cache {
+	c := &Cache{}
+	c.items = map[string]int{}     // want `map field "items" should not be eagerly initialised in constructor "NewAssignedCache"`
+	c.order = make([]string, 0, 8) // want `slice field "order" should not be eagerly initialised in constructor "NewAssignedCache"`
+
+	return c
+}
+
+// Good: zero values are used.
+func NewLazyCache() *Cache {
+	return &Cache{name: "cache"}
+}
+
+// Good: populated containers are not empty.
+func NewSeededCache(seed []string) *Cache {
+	return &Cache{
+		items: map[string]int{"a": 1},
+		order: make([]string, len(seed)),
+	}
+}
+
+// Good: not a constructor.
+func buildCache() *Cache {
+	return &Cache{items: make(map[string]int)}
+}
@@ -0,0 +1,175 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metricname provides an analyzer that checks Prometheus metric names follow the naming conventions.
+package metricname
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_metric_name"
+	doc          = `checks Prometheus metric names follow the naming conventions
+
+Metric names passed in the Name field of the options to prometheus and
+promauto constructors such as NewCounter and NewGaugeVec should be
+snake_case. Counter names should end in _total, and durations should be
+measured in seconds, the base unit, rather than milliseconds or minutes.
+
+Bad:
+    prometheus.NewCounter(prometheus.CounterOpts{
+        Name: "RequestsServed",
+    })
+
+Good:
+    prometheus.NewCounter(prometheus.CounterOpts{
+        Name: "requests_served_total",
+    })`
+)
+
+// Analyzer is the metric name analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// snakeCasePattern matches a valid snake_case metric name.
+var snakeCasePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// metricPackages are the names of the packages providing metric constructors.
+var metricPackages = map[string]bool{
+	"prometheus": true,
+	"promauto":   true,
+}
+
+// constructors holds the metric constructor names, and whether each creates a counter.
+var constructors = map[string]bool{
+	"NewCounter":      true,
+	"NewCounterVec":   true,
+	"NewCounterFunc":  true,
+	"NewGauge":        false,
+	"NewGaugeVec":     false,
+	"NewGaugeFunc":    false,
+	"NewHistogram":    false,
+	"NewHistogramVec": false,
+	"NewSummary":      false,
+	"NewSummaryVec":   false,
+	"NewUntypedFunc":  false,
+}
+
+// nonBaseTimeUnits are name suffixes for time units other than seconds.
+var nonBaseTimeUnits = []string{
+	"_ns", "_nanoseconds", "_us", "_microseconds", "_ms", "_milliseconds",
+	"_minutes", "_hours", "_days",
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+
+			counter, ok := metricConstructor(pass, call)
+			if !ok {
+				return true
+			}
+
+			if lit := nameValue(call.Args[0]); lit != nil {
+				checkName(pass, lit, counter)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// metricConstructor checks if a call is to a metric constructor, either a
+// function or a factory method, and returns whether it creates a counter.
+func metricConstructor(pass *analysis.Pass, call *ast.CallExpr) (bool, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || !metricPackages[fn.Pkg().Name()] {
+		return false, false
+	}
+
+	counter, ok := constructors[fn.Name()]
+
+	return counter, ok
+}
+
+// nameValue returns the value of the Name field in an options composite
+// literal, or nil if it is not set there.
+func nameValue(arg ast.Expr) ast.Expr {
+	lit, ok := ast.Unparen(arg).(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Name" {
+			return kv.Value
+		}
+	}
+
+	return nil
+}
+
+// checkName reports a constant metric name that breaks the conventions.
+func checkName(pass *analysis.Pass, expr ast.Expr, counter bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return
+	}
+
+	name := constant.StringVal(tv.Value)
+
+	switch {
+	case !snakeCasePattern.MatchString(name):
+		pass.Reportf(expr.Pos(), "metric name %q should be snake_case", name)
+	case counter && !strings.HasSuffix(name, "_total"):
+		pass.Reportf(expr.Pos(), "counter name %q should end in _total", name)
+	case hasNonBaseTimeUnit(name):
+		pass.Reportf(expr.Pos(), "metric name %q should use the base unit _seconds", name)
+	}
+}
+
+// hasNonBaseTimeUnit checks if a metric name ends in a time unit other than
+// seconds, allowing for a trailing _total.
+func hasNonBaseTimeUnit(name string) bool {
+	name = strings.TrimSuffix(name, "_total")
+
+	for _, unit := range nonBaseTimeUnits {
+		if strings.HasSuffix(name, unit) {
+			return true
+		}
+	}
+
+	return false
+}
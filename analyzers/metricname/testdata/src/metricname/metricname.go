@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package metricname
+
+import (
+	"metricname/promauto"
+	"metricname/prometheus"
+)
+
+const latencyName = "RequestLatency"
+
+func register(name string) {
+	// Bad: not snake_case.
+	prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "MyMetric", // want `metric name "MyMetric" should be snake_case`
+	})
+
+	prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "active-peers", // want `metric name "active-peers" should be snake_case`
+	})
+
+	// Bad: constant names are resolved.
+	prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: latencyName, // want `metric name "RequestLatency" should be snake_case`
+	})
+
+	// Bad: counters should end in _total.
+	prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "requests_served", // want `counter name "requests_served" should end in _total`
+	}, []string{"result"})
+
+	// Bad: durations should be in seconds.
+	prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "request_duration_ms", // want `metric name "request_duration_ms" should use the base unit _seconds`
+	})
+
+	// Bad: promauto functions and factory methods are checked too.
+	promauto.NewCounter(prometheus.CounterOpts{
+		Name: "Blocks", // want `metric name "Blocks" should be snake_case`
+	})
+
+	promauto.With(nil).NewGauge(prometheus.GaugeOpts{
+		Name: "queueLength", // want `metric name "queueLength" should be snake_case`
+	})
+
+	// Good: conventional names.
+	prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "Vouch",
+		Name:      "requests_served_total",
+	})
+
+	prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "request_duration_seconds",
+	})
+
+	prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "active_peers",
+	})
+
+	// Good: names that are not constant cannot be checked.
+	prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: name,
+	})
+
+	// Good: options built elsewhere are not checked.
+	opts := prometheus.GaugeOpts{Name: "NotChecked"}
+	prometheus.NewGauge(opts)
+}
@@ -0,0 +1,19 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package promauto is a mock promauto package for testing.
+package promauto
+
+import "metricname/prometheus"
+
+// Factory is a mock metric factory.
+type Factory struct{}
+
+// With creates a mock factory.
+func With(r any) Factory { return Factory{} }
+
+// NewGauge creates a mock gauge.
+func (f Factory) NewGauge(opts prometheus.GaugeOpts) prometheus.Gauge { return prometheus.Gauge{} }
+
+// NewCounter creates a mock counter.
+func NewCounter(opts prometheus.CounterOpts) prometheus.Counter { return prometheus.Counter{} }
@@ -0,0 +1,46 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package prometheus is a mock prometheus package for testing.
+package prometheus
+
+// Opts are mock metric options.
+type Opts struct {
+	Namespace string
+	Subsystem string
+	Name      string
+	Help      string
+}
+
+// CounterOpts are mock counter options.
+type CounterOpts Opts
+
+// GaugeOpts are mock gauge options.
+type GaugeOpts Opts
+
+// HistogramOpts are mock histogram options.
+type HistogramOpts Opts
+
+// Counter is a mock counter.
+type Counter struct{}
+
+// CounterVec is a mock counter vector.
+type CounterVec struct{}
+
+// Gauge is a mock gauge.
+type Gauge struct{}
+
+// Histogram is a mock histogram.
+type Histogram struct{}
+
+// NewCounter creates a mock counter.
+func NewCounter(opts CounterOpts) Counter { return Counter{} }
+
+// NewCounterVec creates a mock counter vector.
+func NewCounterVec(opts CounterOpts, labels []string) *CounterVec { return &CounterVec{} }
+
+// NewGauge creates a mock gauge.
+func NewGauge(opts GaugeOpts) Gauge { return Gauge{} }
+
+// NewHistogram creates a mock histogram.
+func NewHistogram(opts HistogramOpts) Histogram { return Histogram{} }
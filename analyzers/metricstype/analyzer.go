@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metricstype provides an analyzer that checks metrics fields use approved metrics types.
+package metricstype
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/attestantio/attgo-linter/internal/typepattern"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_metrics_type"
+	doc          = `checks metrics fields use an approved metrics type
+
+Struct fields named as metrics fields (metrics, monitor, or ending in
+metrics) should hold one of the approved metrics types, not interface{}
+or a homegrown type, so that metrics are registered consistently.
+
+Bad:
+    type Service struct {
+        metrics interface{}
+    }
+
+Good:
+    type Service struct {
+        metrics *prometheus.Registry
+    }`
+)
+
+// NewAnalyzer creates a new metrics type analyzer with the given approved metrics type patterns.
+func NewAnalyzer(metricsTypePatterns []string) *analysis.Analyzer {
+	r := &runner{
+		metricsTypePatterns: metricsTypePatterns,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	metricsTypePatterns []string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			structType, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range structType.Fields.List {
+				for _, name := range field.Names {
+					if !isMetricsFieldName(name.Name) {
+						continue
+					}
+
+					t := pass.TypesInfo.TypeOf(field.Type)
+					if t == nil || typepattern.MatchAny(t, r.metricsTypePatterns) {
+						continue
+					}
+
+					pass.Reportf(name.Pos(), "metrics field %q should use an approved metrics type", name.Name)
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isMetricsFieldName checks if a field name places it in the metrics category,
+// using the same names as attgo_struct_field_order.
+func isMetricsFieldName(name string) bool {
+	lowerName := strings.ToLower(name)
+
+	return lowerName == "metrics" || lowerName == "monitor" || strings.HasSuffix(lowerName, "metrics")
+}
@@ -0,0 +1,30 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package metricstype
+
+import "metricstype/prometheus"
+
+type homegrownMetrics struct{}
+
+type service struct {
+	// Bad: empty interface.
+	metrics interface{} // want `metrics field "metrics" should use an approved metrics type`
+
+	// Bad: homegrown type.
+	monitor *homegrownMetrics // want `metrics field "monitor" should use an approved metrics type`
+
+	// Bad: suffix match, value rather than pointer.
+	requestMetrics prometheus.Registry // want `metrics field "requestMetrics" should use an approved metrics type`
+
+	// Good: other fields are not checked.
+	name string
+}
+
+type goodService struct {
+	// Good: approved registry.
+	metrics *prometheus.Registry
+
+	// Good: approved interface.
+	monitor prometheus.Registerer
+}
@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package prometheus is a mock prometheus package for testing.
+package prometheus
+
+// Registry is a mock registry.
+type Registry struct{}
+
+// Registerer is a mock registerer.
+type Registerer interface {
+	Register() error
+}
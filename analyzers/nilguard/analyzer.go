@@ -0,0 +1,190 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nilguard provides an analyzer that detects pointer parameters dereferenced without a nil check.
+package nilguard
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_nil_guard"
+	doc          = `detects exported functions dereferencing pointer parameters without a nil check
+
+A caller can pass nil for any pointer parameter, and an exported function
+cannot control its callers. Dereferencing the parameter before checking it
+turns a bad argument into a panic rather than an error.
+
+A parameter is reported if its first use in the function body, in source
+order, accesses a field through it or dereferences it with *. A first use
+that compares it with nil counts as a check. Any other first use, such as
+passing it to another function or calling a method on it, is not reported,
+as the nil check may happen there. Uses inside function literals are
+ignored.
+
+Bad:
+    func (s *Service) Submit(req *Request) error {
+        return s.send(req.Body)
+    }
+
+Good:
+    func (s *Service) Submit(req *Request) error {
+        if req == nil {
+            return errors.New("no request supplied")
+        }
+
+        return s.send(req.Body)
+    }`
+)
+
+// Analyzer is the nil guard analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// paramUse is the way a parameter is first used.
+type paramUse uint64
+
+const (
+	paramUseUnknown paramUse = iota
+	paramUseNilCheck
+	paramUseDeref
+	paramUseOther
+)
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			checkFunc(pass, fn)
+		}
+	}
+
+	return nil, nil
+}
+
+// checkFunc reports the pointer parameters of a function whose first use is a dereference.
+func checkFunc(pass *analysis.Pass, fn *ast.FuncDecl) {
+	params := pointerParams(pass, fn)
+	if len(params) == 0 {
+		return
+	}
+
+	firstUses := make(map[types.Object]paramUse, len(params))
+
+	// Record the first use of a parameter, and report it if it is a dereference.
+	record := func(ident *ast.Ident, u paramUse) {
+		obj := pass.TypesInfo.Uses[ident]
+		if !params[obj] || firstUses[obj] != paramUseUnknown {
+			return
+		}
+
+		firstUses[obj] = u
+		if u == paramUseDeref {
+			pass.Reportf(ident.Pos(), "parameter %q is dereferenced without a nil check", ident.Name)
+		}
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			// Function literals may run later, after a check.
+			return false
+		case *ast.BinaryExpr:
+			if ident, ok := nilComparison(pass, node); ok {
+				record(ident, paramUseNilCheck)
+
+				return false
+			}
+		case *ast.SelectorExpr:
+			if ident, ok := ast.Unparen(node.X).(*ast.Ident); ok {
+				if selection, ok := pass.TypesInfo.Selections[node]; ok && selection.Kind() == types.FieldVal {
+					record(ident, paramUseDeref)
+				} else {
+					record(ident, paramUseOther)
+				}
+
+				return false
+			}
+		case *ast.StarExpr:
+			if ident, ok := ast.Unparen(node.X).(*ast.Ident); ok {
+				record(ident, paramUseDeref)
+
+				return false
+			}
+		case *ast.Ident:
+			record(node, paramUseOther)
+		}
+
+		return true
+	})
+}
+
+// pointerParams returns the named pointer parameters of a function, not
+// including its receiver.
+func pointerParams(pass *analysis.Pass, fn *ast.FuncDecl) map[types.Object]bool {
+	params := make(map[types.Object]bool)
+
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			obj := pass.TypesInfo.Defs[name]
+			if obj == nil || name.Name == "_" {
+				continue
+			}
+
+			if _, ok := obj.Type().Underlying().(*types.Pointer); ok {
+				params[obj] = true
+			}
+		}
+	}
+
+	return params
+}
+
+// nilComparison returns the identifier compared with nil by == or !=, if any.
+func nilComparison(pass *analysis.Pass, expr *ast.BinaryExpr) (*ast.Ident, bool) {
+	if expr.Op != token.EQL && expr.Op != token.NEQ {
+		return nil, false
+	}
+
+	operand := expr.X
+	if !isNil(pass, expr.Y) {
+		if !isNil(pass, expr.X) {
+			return nil, false
+		}
+
+		operand = expr.Y
+	}
+
+	ident, ok := ast.Unparen(operand).(*ast.Ident)
+
+	return ident, ok
+}
+
+// isNil checks if an expression is the predeclared nil.
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[expr]
+
+	return ok && tv.IsNil()
+}
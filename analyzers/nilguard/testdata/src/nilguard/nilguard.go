@@ -0,0 +1,106 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nilguard
+
+import "errors"
+
+// Request is a request.
+type Request struct {
+	Body string
+	Size int
+}
+
+// Validate validates the request.
+func (r *Request) Validate() error { return nil }
+
+// Service sends requests.
+type Service struct{}
+
+func (s *Service) send(string) error { return nil }
+
+// Submit dereferences the request without a check.
+func (s *Service) Submit(req *Request) error {
+	return s.send(req.Body) // want `parameter "req" is dereferenced without a nil check`
+}
+
+// SubmitChecked checks the request first.
+func (s *Service) SubmitChecked(req *Request) error {
+	if req == nil {
+		return errors.New("no request supplied")
+	}
+
+	return s.send(req.Body)
+}
+
+// SubmitCombined checks the request in the same condition.
+func (s *Service) SubmitCombined(req *Request) error {
+	if nil == req || req.Body == "" {
+		return errors.New("no request supplied")
+	}
+
+	return s.send(req.Body)
+}
+
+// Copy dereferences the request with *.
+func Copy(req *Request) Request {
+	return *req // want `parameter "req" is dereferenced without a nil check`
+}
+
+// Sizes dereferences the second request without a check.
+func Sizes(a *Request, b *Request) int {
+	if a == nil {
+		return 0
+	}
+
+	return a.Size + (b).Size // want `parameter "b" is dereferenced without a nil check`
+}
+
+// Delegate calls a method first, which may check for nil.
+func Delegate(req *Request) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	return errors.New(req.Body)
+}
+
+// Pass passes the request on first.
+func Pass(req *Request) error {
+	if err := check(req); err != nil {
+		return err
+	}
+
+	return errors.New(req.Body)
+}
+
+// Deferred uses the request in a function literal.
+func Deferred(req *Request) func() string {
+	fn := func() string {
+		return req.Body
+	}
+
+	if req == nil {
+		return nil
+	}
+
+	return fn
+}
+
+// Value takes the request by value.
+func Value(req Request) string {
+	return req.Body
+}
+
+// Unexported functions are not checked.
+func unexported(req *Request) string {
+	return req.Body
+}
+
+func check(req *Request) error {
+	if req == nil {
+		return errors.New("no request supplied")
+	}
+
+	return nil
+}
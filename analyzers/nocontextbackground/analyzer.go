@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nocontextbackground provides an analyzer that detects fabricated contexts.
+// Library code should propagate the caller's context rather than creating a new one.
+package nocontextbackground
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_no_context_background"
+	doc          = `detects context.Background() and context.TODO() outside of main and tests
+
+Code should propagate the context passed by its caller rather than creating
+a new one. A fabricated context cannot be cancelled by the caller and loses
+any deadlines or values it carries.
+
+Package main and _test.go files are never checked. Calls inside func main
+and func init are allowed by default.
+
+Bad:
+    func (s *Service) fetch() error {
+        return s.client.Get(context.Background(), "/data")
+    }
+
+Good:
+    func (s *Service) fetch(ctx context.Context) error {
+        return s.client.Get(ctx, "/data")
+    }`
+)
+
+// NewAnalyzer creates a new no-context-background analyzer.
+// If allowMainInit is true, calls inside func main and func init are not reported.
+func NewAnalyzer(allowMainInit bool) *analysis.Analyzer {
+	r := &runner{
+		allowMainInit: allowMainInit,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	allowMainInit bool
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	// Commands own their contexts, so only library packages are checked.
+	if pass.Pkg.Name() == "main" {
+		return nil, nil
+	}
+
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Package).Filename
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && r.isAllowedFunc(funcDecl) {
+				continue
+			}
+
+			ast.Inspect(decl, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				if isNewContextCall(pass, call) {
+					pass.Reportf(call.Pos(), "do not create a new context; propagate the caller's ctx")
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// isAllowedFunc checks if a function is main or init and calls in it are allowed.
+func (r *runner) isAllowedFunc(fn *ast.FuncDecl) bool {
+	if !r.allowMainInit || fn.Recv != nil {
+		return false
+	}
+
+	return fn.Name.Name == "main" || fn.Name.Name == "init"
+}
+
+// isNewContextCall checks if a call is context.Background() or context.TODO().
+func isNewContextCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "context" {
+		return false
+	}
+
+	return fn.Name() == "Background" || fn.Name() == "TODO"
+}
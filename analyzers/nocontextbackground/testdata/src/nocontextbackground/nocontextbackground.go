@@ -0,0 +1,73 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nocontextbackground
+
+import (
+	"context"
+	ctxpkg "context"
+)
+
+// Bad: package-level context.
+var defaultCtx = context.Background() // want `do not create a new context; propagate the caller's ctx`
+
+// Service is a service.
+type Service struct{}
+
+// Bad: fabricating a context in a method.
+func (s *Service) fetch() {
+	ctx := context.Background() // want `do not create a new context; propagate the caller's ctx`
+	_ = ctx
+}
+
+// Bad: context.TODO is also flagged.
+func process() {
+	use(context.TODO()) // want `do not create a new context; propagate the caller's ctx`
+}
+
+// Bad: renamed import is resolved via type information.
+func renamed() {
+	use(ctxpkg.Background()) // want `do not create a new context; propagate the caller's ctx`
+}
+
+// Bad: calls inside function literals are flagged.
+func literal() {
+	go func() {
+		use(context.Background()) // want `do not create a new context; propagate the caller's ctx`
+	}()
+}
+
+// Good: propagating the caller's context.
+func (s *Service) run(ctx context.Context) {
+	use(ctx)
+}
+
+// Good: deriving from the caller's context.
+func (s *Service) timeout(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	use(ctx)
+}
+
+type local struct{}
+
+// Background is not context.Background.
+func (local) Background() context.Context { return nil }
+
+// Good: a different Background method.
+func shadowed() {
+	var context local
+	use(context.Background())
+}
+
+// Good: init is allowed by default.
+func init() {
+	use(context.Background())
+}
+
+// Bad: a method named init is not the package init.
+func (s *Service) init() {
+	use(context.Background()) // want `do not create a new context; propagate the caller's ctx`
+}
+
+func use(context.Context) {}
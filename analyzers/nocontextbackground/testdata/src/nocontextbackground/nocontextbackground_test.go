@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nocontextbackground
+
+import "context"
+
+// Good: test files may create contexts.
+func testHelper() {
+	use(context.Background())
+}
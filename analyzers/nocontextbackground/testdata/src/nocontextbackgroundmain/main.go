@@ -0,0 +1,21 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package main
+
+import "context"
+
+// Good: helpers in package main are not library code.
+func run() {
+	use(context.Background())
+}
+
+// Good: package main is skipped even when main and init are not allowed.
+func main() {
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	use(ctx)
+	run()
+}
+
+func use(context.Context) {}
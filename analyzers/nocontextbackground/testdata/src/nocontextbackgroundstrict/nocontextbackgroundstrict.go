@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nocontextbackgroundstrict
+
+import "context"
+
+// Bad: init is flagged when main and init are not allowed.
+func init() {
+	use(context.Background()) // want `do not create a new context; propagate the caller's ctx`
+}
+
+func use(context.Context) {}
@@ -0,0 +1,175 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noemptyinterface provides an analyzer that detects interface{} and any in exported APIs.
+package noemptyinterface
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_no_empty_interface"
+	doc          = `detects interface{} and any in exported APIs
+
+Exported function parameters, results and struct fields typed interface{}
+or any lose type safety and push type assertions onto callers. Prefer a
+concrete type, a small interface or a type parameter.
+
+Bad:
+    func (s *Store) Put(key string, value any) error
+
+Good:
+    func (s *Store) Put(key string, value []byte) error
+
+Exceptions:
+- Variadic parameters, as in printf-style functions
+- Encoding functions such as Marshal(v any) and Decode(v any)`
+)
+
+// Analyzer is the no-empty-interface analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// encodingPrefixes are function name prefixes for which any is conventional,
+// as the function works on values of arbitrary type.
+var encodingPrefixes = []string{
+	"Marshal",
+	"Unmarshal",
+	"Encode",
+	"Decode",
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				checkFunc(pass, d)
+			case *ast.GenDecl:
+				checkTypes(pass, d)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func checkFunc(pass *analysis.Pass, funcDecl *ast.FuncDecl) {
+	if !funcDecl.Name.IsExported() || !hasExportedReceiver(funcDecl) || isEncodingFunc(funcDecl.Name.Name) {
+		return
+	}
+
+	if funcDecl.Type.Params != nil {
+		for _, param := range funcDecl.Type.Params.List {
+			// Variadic parameters are how printf-style functions take arbitrary values.
+			if _, isVariadic := param.Type.(*ast.Ellipsis); isVariadic {
+				continue
+			}
+
+			checkType(pass, param.Type)
+		}
+	}
+
+	if funcDecl.Type.Results != nil {
+		for _, result := range funcDecl.Type.Results.List {
+			checkType(pass, result.Type)
+		}
+	}
+}
+
+// hasExportedReceiver checks if a function is not a method or is a method on an exported type.
+func hasExportedReceiver(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Recv == nil || len(funcDecl.Recv.List) == 0 {
+		return true
+	}
+
+	expr := funcDecl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	switch t := expr.(type) {
+	case *ast.IndexExpr:
+		expr = t.X
+	case *ast.IndexListExpr:
+		expr = t.X
+	}
+
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.IsExported()
+}
+
+// isEncodingFunc checks if a function name marks it as working on values of arbitrary type.
+func isEncodingFunc(name string) bool {
+	for _, prefix := range encodingPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkTypes(pass *analysis.Pass, genDecl *ast.GenDecl) {
+	for _, spec := range genDecl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok || !typeSpec.Name.IsExported() {
+			continue
+		}
+
+		structType, ok := typeSpec.Type.(*ast.StructType)
+		if !ok {
+			continue
+		}
+
+		for _, field := range structType.Fields.List {
+			if len(field.Names) == 0 || !field.Names[0].IsExported() {
+				continue
+			}
+
+			checkType(pass, field.Type)
+		}
+	}
+}
+
+// checkType reports a type expression that denotes the empty interface.
+func checkType(pass *analysis.Pass, expr ast.Expr) {
+	t := pass.TypesInfo.TypeOf(expr)
+	if t == nil {
+		return
+	}
+
+	// Named types with an empty interface underlying type are deliberate, and
+	// type parameters are the generic alternative, so only interface{} and any
+	// themselves are reported.
+	switch types.Unalias(t).(type) {
+	case *types.Named, *types.TypeParam:
+		return
+	}
+
+	iface, ok := t.Underlying().(*types.Interface)
+	if !ok || !iface.Empty() {
+		return
+	}
+
+	pass.Reportf(expr.Pos(), "avoid interface{} in exported API; use a concrete type or generics")
+}
@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package noemptyinterface
+
+// Store is an exported type.
+type Store struct {
+	// Bad: exported field typed any.
+	Value any // want `avoid interface\{\} in exported API; use a concrete type or generics`
+
+	// Bad: exported field typed interface{}.
+	Meta interface{} // want `avoid interface\{\} in exported API; use a concrete type or generics`
+
+	// Good: unexported field.
+	cache any
+
+	// Good: concrete type.
+	Name string
+}
+
+// Bad: parameter typed any.
+func (s *Store) Put(key string, value any) error { // want `avoid interface\{\} in exported API; use a concrete type or generics`
+	return nil
+}
+
+// Bad: result typed interface{}.
+func Lookup(key string) interface{} { // want `avoid interface\{\} in exported API; use a concrete type or generics`
+	return nil
+}
+
+// Good: variadic printf-style parameter.
+func Logf(format string, args ...any) {}
+
+// Good: encoding function.
+func Marshal(v any) ([]byte, error) {
+	return nil, nil
+}
+
+// Good: generics.
+func Get[T any](key string) T {
+	var zero T
+
+	return zero
+}
+
+// Good: unexported function.
+func lookup(key string) any {
+	return nil
+}
+
+type internal struct{}
+
+// Good: method on an unexported type.
+func (i *internal) Value() any {
+	return nil
+}
+
+// Payload is a deliberately open type.
+type Payload interface{}
+
+// Good: named types are deliberate.
+func Send(p Payload) {}
+
+// Good: unexported struct.
+type record struct {
+	Data any
+}
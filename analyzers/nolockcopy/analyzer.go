@@ -0,0 +1,106 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nolockcopy provides an analyzer that detects value receivers on types containing a mutex.
+// Calling such a method copies the mutex, so the method locks a copy rather than the shared lock.
+package nolockcopy
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_no_lock_copy"
+	doc          = `detects value receivers on types containing a sync.Mutex or sync.RWMutex
+
+A method with a value receiver operates on a copy of its receiver. If the
+type holds a mutex, directly, embedded or within a nested struct or array
+field, every call copies the lock: the method then locks its own copy and
+does not protect the shared state. Methods on such types must use pointer
+receivers.
+
+Bad:
+    type Service struct {
+        mu    sync.Mutex
+        count int
+    }
+
+    func (s Service) Count() int {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        return s.count
+    }
+
+Good:
+    func (s *Service) Count() int {
+        s.mu.Lock()
+        defer s.mu.Unlock()
+        return s.count
+    }`
+)
+
+// Analyzer is the no-lock-copy analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+
+			recv := fn.Recv.List[0]
+
+			named, ok := pass.TypesInfo.TypeOf(recv.Type).(*types.Named)
+			if !ok || !containsLock(named) {
+				continue
+			}
+
+			pass.Reportf(recv.Type.Pos(), "method %q copies lock-containing type %q; use a pointer receiver",
+				fn.Name.Name, named.Obj().Name())
+		}
+	}
+
+	return nil, nil
+}
+
+// containsLock checks if copying a value of a type copies a sync.Mutex or
+// sync.RWMutex, held directly or in a struct field or array element. Other
+// types from package sync, such as sync.Once, are not searched for the
+// locks in their implementation.
+func containsLock(t types.Type) bool {
+	if named, ok := types.Unalias(t).(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "sync" {
+		return named.Obj().Name() == "Mutex" || named.Obj().Name() == "RWMutex"
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		for field := range u.Fields() {
+			if containsLock(field.Type()) {
+				return true
+			}
+		}
+	case *types.Array:
+		return containsLock(u.Elem())
+	}
+
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nolockcopy
+
+import "sync"
+
+// Bad: value receiver on a struct with a mutex field.
+type Service struct {
+	count int
+	mu    sync.Mutex
+}
+
+func (s Service) Do() int { // want `method "Do" copies lock-containing type "Service"; use a pointer receiver`
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.count
+}
+
+// Good: pointer receiver.
+func (s *Service) Increment() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.count++
+}
+
+// Bad: embedded read-write mutex.
+type Cache struct {
+	sync.RWMutex
+	entries map[string]string
+}
+
+func (c Cache) Get(key string) string { // want `method "Get" copies lock-containing type "Cache"; use a pointer receiver`
+	return c.entries[key]
+}
+
+// Bad: lock in a nested struct field.
+type state struct {
+	mu sync.Mutex
+}
+
+type Registry struct {
+	state state
+}
+
+func (Registry) Name() string { // want `method "Name" copies lock-containing type "Registry"; use a pointer receiver`
+	return "registry"
+}
+
+// Bad: lock in an array of structs.
+type Shards struct {
+	shards [4]state
+}
+
+func (s Shards) Len() int { // want `method "Len" copies lock-containing type "Shards"; use a pointer receiver`
+	return len(s.shards)
+}
+
+// Bad: generic type containing a lock.
+type Box[T any] struct {
+	mu    sync.Mutex
+	value T
+}
+
+func (b Box[T]) Value() T { // want `method "Value" copies lock-containing type "Box"; use a pointer receiver`
+	return b.value
+}
+
+// Good: a pointer to a mutex is shared, not copied.
+type Handle struct {
+	mu *sync.Mutex
+}
+
+func (h Handle) Lock() {
+	h.mu.Lock()
+}
+
+// Good: other sync types are left to go vet.
+type Group struct {
+	once sync.Once
+}
+
+func (g Group) Name() string {
+	return "group"
+}
+
+// Good: no lock.
+type Point struct {
+	x, y int
+}
+
+func (p Point) X() int {
+	return p.x
+}
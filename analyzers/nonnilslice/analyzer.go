@@ -0,0 +1,166 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nonnilslice provides an analyzer that checks list functions return empty slices rather than nil.
+// A nil slice marshals to JSON null, whereas an empty slice marshals to [].
+package nonnilslice
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_non_nil_slice"
+	doc          = `checks list functions return an empty slice rather than nil
+
+Ranging over a nil slice is the same as ranging over an empty one, but
+encoding/json marshals a nil slice as null and an empty slice as []. For
+consistent APIs, functions and methods whose name starts with List or
+GetAll must not return nil for a slice result. A fix replaces nil with an
+empty slice literal.
+
+Bad:
+    func (s *Service) ListUsers() ([]*User, error) {
+        if s.empty {
+            return nil, nil
+        }
+        ...
+    }
+
+Good:
+    func (s *Service) ListUsers() ([]*User, error) {
+        if s.empty {
+            return []*User{}, nil
+        }
+        ...
+    }`
+)
+
+// Analyzer is the non-nil slice analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// listPrefixes are the function name prefixes that identify list functions.
+var listPrefixes = []string{"List", "GetAll"}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Type.Results == nil || !isListName(fn.Name.Name) {
+				continue
+			}
+
+			checkFunc(pass, fn)
+		}
+	}
+
+	return nil, nil
+}
+
+// isListName checks if a function name starts with a list prefix followed
+// by the end of the name or a new CamelCase word, so ListUsers and List
+// match but Listen does not.
+func isListName(name string) bool {
+	for _, prefix := range listPrefixes {
+		rest, ok := strings.CutPrefix(name, prefix)
+		if !ok {
+			continue
+		}
+
+		r, _ := utf8.DecodeRuneInString(rest)
+		if rest == "" || !unicode.IsLower(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFunc reports nil returned for the slice results of a list function.
+func checkFunc(pass *analysis.Pass, fn *ast.FuncDecl) {
+	// The type expression of each result, in order.
+	var results []ast.Expr
+	for _, field := range fn.Type.Results.List {
+		for range max(len(field.Names), 1) {
+			results = append(results, field.Type)
+		}
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			// Returns in function literals belong to the literal.
+			return false
+		case *ast.ReturnStmt:
+			if len(node.Results) != len(results) {
+				return true
+			}
+
+			for i, result := range node.Results {
+				if isNil(pass, result) && isSlice(pass, results[i]) {
+					reportNil(pass, fn.Name.Name, result, results[i])
+				}
+			}
+		}
+
+		return true
+	})
+}
+
+// isNil checks if an expression is the predeclared nil.
+func isNil(pass *analysis.Pass, expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+
+	return ok && pass.TypesInfo.Uses[ident] == types.Universe.Lookup("nil")
+}
+
+// isSlice checks if a type expression denotes a slice type.
+func isSlice(pass *analysis.Pass, typ ast.Expr) bool {
+	t := pass.TypesInfo.TypeOf(typ)
+	if t == nil {
+		return false
+	}
+
+	_, ok := t.Underlying().(*types.Slice)
+
+	return ok
+}
+
+// reportNil reports a nil result, with a fix returning an empty slice of
+// the result type instead.
+func reportNil(pass *analysis.Pass, funcName string, result ast.Expr, typ ast.Expr) {
+	pass.Report(analysis.Diagnostic{
+		Pos:     result.Pos(),
+		End:     result.End(),
+		Message: fmt.Sprintf("%q should return an empty slice, not nil", funcName),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Return an empty slice",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     result.Pos(),
+				End:     result.End(),
+				NewText: []byte(types.ExprString(typ) + "{}"),
+			}},
+		}},
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nonnilslice
+
+import "errors"
+
+type User struct{}
+
+type Users []User
+
+type Service struct {
+	empty bool
+}
+
+// Bad: nil returned for a slice result.
+func (s *Service) ListUsers() ([]*User, error) {
+	if s.empty {
+		return nil, nil // want `"ListUsers" should return an empty slice, not nil`
+	}
+
+	return []*User{{}}, nil
+}
+
+// Bad: only the slice result is reported.
+func (s *Service) ListNames() ([]string, error) {
+	if s.empty {
+		return nil, errors.New("empty") // want `"ListNames" should return an empty slice, not nil`
+	}
+
+	return []string{"a"}, nil
+}
+
+// Bad: named slice types.
+func GetAllUsers() Users {
+	return nil // want `"GetAllUsers" should return an empty slice, not nil`
+}
+
+// Bad: a bare List function.
+func List() (ids []int) {
+	return nil // want `"List" should return an empty slice, not nil`
+}
+
+// Good: Listen is not a list function.
+func Listen() []string {
+	return nil
+}
+
+// Good: not a list function.
+func FindUsers() []User {
+	return nil
+}
+
+// Good: map results are not slices.
+func ListIndex() map[string]int {
+	return nil
+}
+
+// Good: returns in function literals belong to the literal.
+func ListLater() func() []int {
+	return func() []int {
+		return nil
+	}
+}
+
+// Good: an empty slice.
+func ListEmpty() []User {
+	return []User{}
+}
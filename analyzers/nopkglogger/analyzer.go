@@ -18,10 +18,12 @@ package nopkglogger
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
-	"strings"
 
+	"github.com/attestantio/attgo-linter/internal/typepattern"
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
 )
 
 const (
@@ -42,11 +44,25 @@ Good:
     }`
 )
 
+// Option configures the no-pkg-logger analyzer.
+type Option func(*runner)
+
+// WithNopConstructors sets the no-op logger constructors, such as "zerolog.Nop",
+// whose results may be held in package-level variables as immutable sentinels.
+func WithNopConstructors(nopConstructors []string) Option {
+	return func(r *runner) {
+		r.nopConstructors = nopConstructors
+	}
+}
+
 // NewAnalyzer creates a new no-pkg-logger analyzer with the given logger type patterns.
-func NewAnalyzer(loggerTypePatterns []string) *analysis.Analyzer {
+func NewAnalyzer(loggerTypePatterns []string, opts ...Option) *analysis.Analyzer {
 	r := &runner{
 		loggerTypePatterns: loggerTypePatterns,
 	}
+	for _, opt := range opts {
+		opt(r)
+	}
 
 	return &analysis.Analyzer{
 		Name: analyzerName,
@@ -57,13 +73,15 @@ func NewAnalyzer(loggerTypePatterns []string) *analysis.Analyzer {
 
 type runner struct {
 	loggerTypePatterns []string
+	nopConstructors    []string
 }
 
 func (r *runner) run(pass *analysis.Pass) (any, error) {
 	for _, file := range pass.Files {
 		for _, decl := range file.Decls {
+			// Constants cannot hold loggers, so only variables are checked.
 			genDecl, ok := decl.(*ast.GenDecl)
-			if !ok {
+			if !ok || genDecl.Tok != token.VAR {
 				continue
 			}
 
@@ -74,7 +92,7 @@ func (r *runner) run(pass *analysis.Pass) (any, error) {
 				}
 
 				// Check each variable in the declaration.
-				for _, name := range valueSpec.Names {
+				for i, name := range valueSpec.Names {
 					obj := pass.TypesInfo.ObjectOf(name)
 					if obj == nil {
 						continue
@@ -85,8 +103,14 @@ func (r *runner) run(pass *analysis.Pass) (any, error) {
 						continue
 					}
 
-					// Check if the type matches any logger pattern.
-					if r.isLoggerType(obj.Type()) {
+					// No-op loggers are acceptable sentinels.
+					if len(valueSpec.Values) == len(valueSpec.Names) && r.isNopConstructorCall(pass, valueSpec.Values[i]) {
+						continue
+					}
+
+					// Check if the declared type, or the type of the value assigned
+					// to this name, matches any logger pattern.
+					if r.isLoggerType(obj.Type()) || r.isLoggerType(valueType(pass, valueSpec, i)) {
 						pass.Reportf(name.Pos(),
 							"package-level logger %q detected; loggers should be struct fields for better dependency injection and testability",
 							name.Name)
@@ -101,48 +125,46 @@ func (r *runner) run(pass *analysis.Pass) (any, error) {
 
 // isLoggerType checks if the given type matches any of the configured logger patterns.
 func (r *runner) isLoggerType(t types.Type) bool {
-	typeName := typeString(t)
+	if t == nil {
+		return false
+	}
 
-	for _, pattern := range r.loggerTypePatterns {
-		if matchTypePattern(typeName, pattern) {
-			return true
+	return typepattern.MatchAny(t, r.loggerTypePatterns)
+}
+
+// valueType returns the type of the value assigned to the i-th name of a value
+// spec, aligning names with values or with the results of a single call, or nil
+// if the spec assigns no value to it.
+func valueType(pass *analysis.Pass, valueSpec *ast.ValueSpec, i int) types.Type {
+	switch len(valueSpec.Values) {
+	case len(valueSpec.Names):
+		return pass.TypesInfo.TypeOf(valueSpec.Values[i])
+	case 1:
+		tuple, ok := pass.TypesInfo.TypeOf(valueSpec.Values[0]).(*types.Tuple)
+		if ok && i < tuple.Len() {
+			return tuple.At(i).Type()
 		}
 	}
 
-	return false
+	return nil
 }
 
-// typeString returns a string representation of the type suitable for pattern matching.
-func typeString(t types.Type) string {
-	// Get the full type string including package path.
-	return types.TypeString(t, nil)
-}
+// isNopConstructorCall checks if an expression calls one of the configured no-op logger constructors.
+func (r *runner) isNopConstructorCall(pass *analysis.Pass, expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
 
-// matchTypePattern checks if a type string matches a pattern.
-// Patterns can be:
-// - Exact match: "zerolog.Logger"
-// - Pointer: "*zerolog.Logger"
-// The type string from types.TypeString includes the full package path,
-// so we match against the suffix.
-func matchTypePattern(typeName, pattern string) bool {
-	// Handle pointer patterns.
-	if strings.HasPrefix(pattern, "*") {
-		if !strings.HasPrefix(typeName, "*") {
-			return false
-		}
-		typeName = strings.TrimPrefix(typeName, "*")
-		pattern = strings.TrimPrefix(pattern, "*")
-	} else if strings.HasPrefix(typeName, "*") {
-		// Pattern is not pointer but type is.
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
 		return false
 	}
 
-	// Check if the type name ends with the pattern (handles full package paths).
-	// e.g., "github.com/rs/zerolog.Logger" ends with "zerolog.Logger"
-	if strings.HasSuffix(typeName, pattern) {
-		// Ensure we match at a package boundary.
-		prefix := strings.TrimSuffix(typeName, pattern)
-		if prefix == "" || strings.HasSuffix(prefix, "/") || strings.HasSuffix(prefix, ".") {
+	// Match against the full path, e.g. "github.com/rs/zerolog.Nop", in the same way as types.
+	funcName := fn.Pkg().Path() + "." + fn.Name()
+	for _, pattern := range r.nopConstructors {
+		if typepattern.Match(funcName, pattern) {
 			return true
 		}
 	}
@@ -36,3 +36,14 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, analyzer, "nopkglogger")
 }
+
+func TestAnalyzerNopConstructors(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := nopkglogger.NewAnalyzer(
+		[]string{"zerolog.Logger", "*zerolog.Logger"},
+		nopkglogger.WithNopConstructors([]string{"zerolog.Nop", "zap.NewNop"}),
+	)
+
+	analysistest.Run(t, testdata, analyzer, "nopkgloggernop")
+}
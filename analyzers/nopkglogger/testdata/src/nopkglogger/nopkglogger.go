@@ -27,6 +27,26 @@ var (
 	logger2 *zerolog.Logger // want `package-level logger "logger2" detected; loggers should be struct fields for better dependency injection and testability`
 )
 
+// Bad: grouped declarations with inferred types, each from its own value.
+var (
+	inferred1, inferred2 = zerolog.New(), newLoggerPtr() // want `package-level logger "inferred1" detected` `package-level logger "inferred2" detected`
+	name, inferred3      = "svc", zerolog.New()          // want `package-level logger "inferred3" detected`
+)
+
+// Bad: a logger assigned to an interface-typed variable.
+var sink, other any = zerolog.New(), 1 // want `package-level logger "sink" detected`
+
+// Bad: a logger among the results of a single call.
+var pair1, pair2 = newPair() // want `package-level logger "pair1" detected`
+
+func newLoggerPtr() *zerolog.Logger {
+	return &zerolog.Logger{}
+}
+
+func newPair() (zerolog.Logger, int) {
+	return zerolog.Logger{}, 0
+}
+
 // Good: non-logger package variables are fine.
 var (
 	version = "1.0.0"
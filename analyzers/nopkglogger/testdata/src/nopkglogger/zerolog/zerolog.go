@@ -25,3 +25,9 @@ type Event struct{}
 
 // Msg logs a message.
 func (e *Event) Msg(string) {}
+
+// Nop returns a disabled logger.
+func Nop() Logger { return Logger{} }
+
+// New returns a logger.
+func New() Logger { return Logger{} }
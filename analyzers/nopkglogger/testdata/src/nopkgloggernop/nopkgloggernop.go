@@ -0,0 +1,18 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package nopkgloggernop
+
+import "nopkglogger/zerolog"
+
+// Good: no-op logger used as an immutable sentinel.
+var nopLogger = zerolog.Nop()
+
+// Good: explicitly typed no-op logger.
+var typedNop zerolog.Logger = zerolog.Nop()
+
+// Bad: a real logger global.
+var log = zerolog.New() // want `package-level logger "log" detected`
+
+// Bad: only the no-op logger in a multi-value declaration is exempt.
+var nop, realLog = zerolog.Nop(), zerolog.New() // want `package-level logger "realLog" detected`
@@ -0,0 +1,102 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package paramcount provides an analyzer that detects functions with long parameter lists.
+package paramcount
+
+import (
+	"go/ast"
+
+	"github.com/attestantio/attgo-linter/internal/contextparam"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_param_count"
+	doc          = `detects functions and methods with too many parameters
+
+Long parameter lists are hard to read at call sites, where arguments of
+the same type are easily swapped. Functions with more than the configured
+number of parameters should take a struct instead.
+
+A context.Context parameter is not counted. Each name in a group such as
+a, b int counts as one parameter, as does a variadic parameter.
+
+Bad:
+    func Process(ctx context.Context, id, name, region string, retries, timeout int, verbose bool) error
+
+Good:
+    type ProcessParams struct {
+        ID      string
+        Name    string
+        ...
+    }
+
+    func Process(ctx context.Context, params *ProcessParams) error`
+)
+
+// NewAnalyzer creates a new parameter count analyzer reporting functions
+// with more than maxParams parameters, not counting a context.
+func NewAnalyzer(maxParams int) *analysis.Analyzer {
+	r := &runner{
+		maxParams: maxParams,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	maxParams int
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+
+			if count := countParams(fn.Type.Params); count > r.maxParams {
+				pass.Reportf(fn.Name.Pos(),
+					"function %q has %d parameters; consider a struct", fn.Name.Name, count)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// countParams counts the parameters in a parameter list, excluding contexts.
+func countParams(params *ast.FieldList) int {
+	if params == nil {
+		return 0
+	}
+
+	count := 0
+
+	for _, param := range params.List {
+		if contextparam.Is(param) {
+			continue
+		}
+
+		// A field with no names is a single unnamed parameter.
+		count += max(len(param.Names), 1)
+	}
+
+	return count
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package paramcount
+
+import "context"
+
+// Bad: seven parameters.
+func Process(id, name, region string, retries, timeout int, verbose bool, tags []string) error { // want `function "Process" has 7 parameters; consider a struct`
+	return nil
+}
+
+type Worker struct{}
+
+// Bad: methods are checked too, and unnamed parameters count one each.
+func (w *Worker) Run(string, string, int, int, bool, bool) { // want `function "Run" has 6 parameters; consider a struct`
+}
+
+// Good: the context is not counted.
+func Fetch(ctx context.Context, id, name, region string, retries, timeout int) error {
+	return nil
+}
+
+// Good: exactly at the limit.
+func Store(a, b, c, d int, values ...string) {}
+
+// Bad: a context does not hide the other parameters.
+func Sync(ctx context.Context, a, b, c, d, e, f int) { // want `function "Sync" has 6 parameters; consider a struct`
+}
+
+// Good: few parameters.
+func Close() {}
@@ -15,9 +15,14 @@
 package rawstring
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
 )
@@ -38,40 +43,226 @@ Good:
 Exceptions:
 - Strings containing backticks (cannot use raw string)
 - Strings with actual newlines intended as \n
-- Short strings with minimal escaping`
+- Short strings with minimal escaping
+
+A string followed by a //attgo:keep-escaped comment on the same line is
+not reported.
+
+A fix converting the string to a raw string is offered unless its value
+contains a control character, such as a newline, tab or form feed, or is
+not valid UTF-8, as these cannot be written legibly in a raw string.
+
+Optionally, strings are only reported if escape sequences make up at least
+a minimum ratio of the length of their value, so that long strings with a
+few escapes are left alone.
+
+Optionally, the complement is also checked: raw strings containing no
+quotes, backslashes or newlines should be double-quoted strings.`
 )
 
-// Analyzer is the raw string preference analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Analyzer is the raw string preference analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the raw string analyzer.
+type Option func(*runner)
+
+// WithSummaryOnly sets whether the analyzer reports a single summary per file,
+// counting the strings that could be raw strings, instead of one diagnostic per string.
+func WithSummaryOnly(summaryOnly bool) Option {
+	return func(r *runner) {
+		r.summaryOnly = summaryOnly
+	}
+}
+
+// WithReportUnneeded sets whether raw strings that contain no quotes,
+// backslashes or other special characters are reported.
+func WithReportUnneeded(reportUnneeded bool) Option {
+	return func(r *runner) {
+		r.reportUnneeded = reportUnneeded
+	}
+}
+
+// WithSkipFilePatterns sets glob patterns, such as "*.pb.go", matched against
+// file base names; matching files are not checked.
+func WithSkipFilePatterns(patterns []string) Option {
+	return func(r *runner) {
+		r.skipFilePatterns = patterns
+	}
+}
+
+// WithMinEscapeRatio sets the minimum ratio of escape sequences to the length
+// of a string's value for it to be reported, in addition to the minimum
+// number of escape sequences. A ratio of 0 disables the check.
+func WithMinEscapeRatio(ratio float64) Option {
+	return func(r *runner) {
+		r.minEscapeRatio = ratio
+	}
+}
+
+// NewAnalyzer creates a new raw string analyzer with the given options.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	summaryOnly      bool
+	reportUnneeded   bool
+	skipFilePatterns []string
+	minEscapeRatio   float64
 }
 
 // minEscapesForWarning is the minimum number of escape sequences to trigger a warning.
 const minEscapesForWarning = 3
 
-func run(pass *analysis.Pass) (any, error) {
+// keepEscapedDirective marks a line whose escaped strings should stay as they are.
+const keepEscapedDirective = "//attgo:keep-escaped"
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
 	for _, file := range pass.Files {
+		if r.isSkippedFile(pass.Fset.Position(file.Package).Filename) {
+			continue
+		}
+
+		convertible := 0
+		unneeded := 0
+		tags := structTags(file)
+		keptLines := keepEscapedLines(pass.Fset, file)
+
 		ast.Inspect(file, func(n ast.Node) bool {
 			lit, ok := n.(*ast.BasicLit)
 			if !ok || lit.Kind != token.STRING {
 				return true
 			}
 
-			checkStringLiteral(pass, lit)
+			if keptLines[pass.Fset.Position(lit.Pos()).Line] {
+				return true
+			}
+
+			if r.reportUnneeded && !tags[lit] && isUnneededRawString(lit) {
+				if r.summaryOnly {
+					unneeded++
+				} else {
+					pass.Reportf(lit.Pos(), "raw string has no special characters; use a double-quoted string")
+				}
+
+				return true
+			}
+
+			escapeCount, ok := r.checkStringLiteral(lit)
+			if !ok {
+				return true
+			}
+
+			if r.summaryOnly {
+				convertible++
+			} else {
+				pass.Report(analysis.Diagnostic{
+					Pos: lit.Pos(),
+					Message: fmt.Sprintf(
+						"string has %d escape sequences; consider using a raw string (backticks) for better readability",
+						escapeCount),
+					SuggestedFixes: rawStringFix(lit),
+				})
+			}
 
 			return true
 		})
+
+		if convertible > 0 {
+			pass.Reportf(file.Package, "%d strings could be raw strings", convertible)
+		}
+
+		if unneeded > 0 {
+			pass.Reportf(file.Package, "%d raw strings could be double-quoted strings", unneeded)
+		}
 	}
 
 	return nil, nil
 }
 
-func checkStringLiteral(pass *analysis.Pass, lit *ast.BasicLit) {
+// isSkippedFile checks if a file matches one of the skip patterns.
+func (r *runner) isSkippedFile(filename string) bool {
+	base := filepath.Base(filename)
+
+	for _, pattern := range r.skipFilePatterns {
+		if matched, err := filepath.Match(pattern, base); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// structTags returns the struct field tags in a file, which are
+// conventionally raw strings whatever their content.
+func structTags(file *ast.File) map[*ast.BasicLit]bool {
+	tags := make(map[*ast.BasicLit]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if field, ok := n.(*ast.Field); ok && field.Tag != nil {
+			tags[field.Tag] = true
+		}
+
+		return true
+	})
+
+	return tags
+}
+
+// keepEscapedLines returns the lines in a file that end with a
+// //attgo:keep-escaped comment, optionally followed by a reason.
+func keepEscapedLines(fset *token.FileSet, file *ast.File) map[int]bool {
+	lines := make(map[int]bool)
+
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			rest, ok := strings.CutPrefix(c.Text, keepEscapedDirective)
+			if !ok || (rest != "" && !unicode.IsSpace(rune(rest[0]))) {
+				continue
+			}
+
+			lines[fset.Position(c.Pos()).Line] = true
+		}
+	}
+
+	return lines
+}
+
+// isUnneededRawString checks if a literal is a raw string that could be
+// written as a double-quoted string without any escape sequences.
+// This is the complement of checkStringLiteral, which only reports
+// double-quoted strings, so the two never report the same literal.
+func isUnneededRawString(lit *ast.BasicLit) bool {
+	if !strings.HasPrefix(lit.Value, "`") {
+		return false
+	}
+
+	content := lit.Value[1 : len(lit.Value)-1]
+
+	for _, r := range content {
+		if r == '"' || r == '\\' || !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkStringLiteral returns the number of escape sequences in a string literal
+// and whether it should be written as a raw string.
+func (r *runner) checkStringLiteral(lit *ast.BasicLit) (int, bool) {
 	// Only check double-quoted strings.
 	if !strings.HasPrefix(lit.Value, `"`) {
-		return // Already a raw string.
+		return 0, false // Already a raw string.
 	}
 
 	value := lit.Value
@@ -80,17 +271,40 @@ func checkStringLiteral(pass *analysis.Pass, lit *ast.BasicLit) {
 	// Need to check the interpreted value.
 	interpreted := interpretString(value)
 	if strings.Contains(interpreted, "`") {
-		return
+		return 0, false
 	}
 
 	// Count escape sequences.
 	escapeCount := countEscapes(value)
+	if escapeCount < minEscapesForWarning {
+		return escapeCount, false
+	}
 
-	if escapeCount >= minEscapesForWarning {
-		pass.Reportf(lit.Pos(),
-			"string has %d escape sequences; consider using a raw string (backticks) for better readability",
-			escapeCount)
+	// Long strings with few escapes gain little from being raw strings.
+	if r.minEscapeRatio > 0 && float64(escapeCount)/float64(len(interpreted)) < r.minEscapeRatio {
+		return escapeCount, false
 	}
+
+	return escapeCount, true
+}
+
+// rawStringFix returns a fix converting a double-quoted string literal to a
+// raw string, or nil if its value contains characters that a raw string
+// would embed literally, such as control characters or invalid UTF-8.
+func rawStringFix(lit *ast.BasicLit) []analysis.SuggestedFix {
+	interpreted := interpretString(lit.Value)
+	if !utf8.ValidString(interpreted) || strings.ContainsFunc(interpreted, unicode.IsControl) {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message: "Convert to a raw string",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     lit.Pos(),
+			End:     lit.End(),
+			NewText: []byte("`" + interpreted + "`"),
+		}},
+	}}
 }
 
 // countEscapes counts the number of escape sequences in a double-quoted string literal.
@@ -131,49 +345,13 @@ func countEscapes(s string) int {
 	return count
 }
 
-// interpretString interprets a Go string literal.
+// interpretString interprets a Go string literal, returning an empty string
+// if it is not valid.
 func interpretString(s string) string {
-	if len(s) < 2 {
+	value, err := strconv.Unquote(s)
+	if err != nil {
 		return ""
 	}
 
-	// Remove surrounding quotes.
-	s = s[1 : len(s)-1]
-
-	var result strings.Builder
-
-	i := 0
-
-	for i < len(s) {
-		if s[i] == '\\' && i+1 < len(s) {
-			switch s[i+1] {
-			case 'n':
-				result.WriteByte('\n')
-			case 't':
-				result.WriteByte('\t')
-			case 'r':
-				result.WriteByte('\r')
-			case '\\':
-				result.WriteByte('\\')
-			case '"':
-				result.WriteByte('"')
-			case '\'':
-				result.WriteByte('\'')
-			case '`':
-				result.WriteByte('`')
-			default:
-				// For simplicity, just write the escaped char.
-				result.WriteByte(s[i+1])
-			}
-
-			i += 2
-
-			continue
-		}
-
-		result.WriteByte(s[i])
-		i++
-	}
-
-	return result.String()
+	return value
 }
@@ -25,3 +25,41 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, rawstring.Analyzer, "rawstring")
 }
+
+func TestAnalyzerSummaryOnly(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, rawstring.NewAnalyzer(rawstring.WithSummaryOnly(true)), "rawstringsummary")
+}
+
+func TestAnalyzerSkipFilePatterns(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := rawstring.NewAnalyzer(rawstring.WithSkipFilePatterns([]string{"*.pb.go"}))
+	analysistest.Run(t, testdata, analyzer, "rawstringskip")
+}
+
+func TestAnalyzerReportUnneeded(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, rawstring.NewAnalyzer(rawstring.WithReportUnneeded(true)), "rawstringunneeded")
+}
+
+func TestAnalyzerReportUnneededSummaryOnly(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := rawstring.NewAnalyzer(rawstring.WithReportUnneeded(true), rawstring.WithSummaryOnly(true))
+	analysistest.Run(t, testdata, analyzer, "rawstringunneededsummary")
+}
+
+func TestAnalyzerSuggestedFix(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.RunWithSuggestedFixes(t, testdata, rawstring.Analyzer, "rawstringfix")
+}
+
+func TestAnalyzerMinEscapeRatio(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analysistest.Run(t, testdata, rawstring.NewAnalyzer(rawstring.WithMinEscapeRatio(0.2)), "rawstringratio")
+}
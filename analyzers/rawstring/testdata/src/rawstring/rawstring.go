@@ -33,6 +33,19 @@ var rawWithQuotes = `she said "hello"`
 // Bad: JSON with escapes (6 escape sequences: 3 pairs of \").
 var jsonStr = "{\"key\": \"value\", \"num\": 123}" // want `string has 6 escape sequences; consider using a raw string`
 
+// Good: kept escaped to match an external specification.
+var specPath = "C:\\Program Files\\Vendor\\spec.txt" //attgo:keep-escaped
+
+// Good: kept escaped, with a reason.
+var specQuery = "{\"a\": \"b\"}" //attgo:keep-escaped matches the upstream test vector
+
+// Bad: the directive must be on the same line as the string.
+//attgo:keep-escaped
+var unkept = "C:\\Program Files\\Vendor\\other.txt" // want `string has 3 escape sequences; consider using a raw string`
+
+// Bad: a similar comment is not the directive.
+var misspelt = "C:\\Program Files\\Vendor\\misspelt.txt" //attgo:keep-escapedness // want `string has 3 escape sequences; consider using a raw string`
+
 func examples() {
 	// Local variables also checked (3 backslash escapes).
 	_ = "path\\to\\file\\name" // want `string has 3 escape sequences; consider using a raw string`
@@ -0,0 +1,25 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringfix
+
+// Bad, fixed: only quotes are escaped.
+var jsonStr = "{\"key\": \"value\"}" // want `string has 4 escape sequences; consider using a raw string`
+
+// Bad, fixed: a Unicode escape for a printable character.
+var accented = "caf\u00e9 \\x\\y" // want `string has 3 escape sequences; consider using a raw string`
+
+// Bad, not fixed: a raw string would embed a literal form feed.
+var formFeed = "\f\"a\" \"b\"" // want `string has 5 escape sequences; consider using a raw string`
+
+// Bad, not fixed: a raw string would embed a literal vertical tab.
+var verticalTab = "\v\\x\\y" // want `string has 3 escape sequences; consider using a raw string`
+
+// Bad, not fixed: a raw string would embed a literal carriage return.
+var carriageReturn = "\"a\"\r\"b\"" // want `string has 4 escape sequences; consider using a raw string`
+
+// Bad, not fixed: a raw string would span lines.
+var newline = "\"a\"\n\"b\"" // want `string has 4 escape sequences; consider using a raw string`
+
+// Bad, not fixed: the value is not valid UTF-8.
+var invalid = "\xff\\a\\b" // want `string has 3 escape sequences; consider using a raw string`
@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringratio
+
+// Bad: a short string made mostly of escapes passes both the count and the ratio.
+var pattern = "\"a\",\"b\"" // want `string has 4 escape sequences; consider using a raw string \(backticks\) for better readability`
+
+// Good: a long string with a few escapes is below the ratio.
+var message = "the service could not read the \"config\" file from the data directory, so it fell back to the \"defaults\" built into the binary"
+
+// Good: a dense string with too few escapes is below the count.
+var short = "\"a\""
@@ -0,0 +1,9 @@
+// Code produced by an older protobuf plugin without the standard header.
+// source: descriptor.proto
+
+package rawstringskip
+
+// Skipped: escaped descriptor bytes in a .pb.go file.
+var fileDescriptor = "\x0a\x10descriptor.proto\x12\x04test\"\x1a\x0a\x07Message\x12\x0f\x0a\x04name\x18\x01"
+
+var rawDesc = []byte("\x0a\x0b\x12\x09\"\\\"\\")
@@ -0,0 +1,7 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringskip
+
+// Bad: ordinary files are still checked.
+var path = "C:\\Users\\name\\Documents\\file.txt" // want `string has 4 escape sequences`
@@ -0,0 +1,7 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringsummary
+
+// No convertible strings, so no summary for this file.
+var plain = "hello world"
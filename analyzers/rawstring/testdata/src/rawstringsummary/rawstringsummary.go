@@ -0,0 +1,21 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringsummary // want `3 strings could be raw strings`
+
+// Counted: four escapes.
+var path = "C:\\Users\\name\\Documents\\file.txt"
+
+// Counted: three escaped quotes.
+var json = "{\"a\": \"b\", \"c\": 1}"
+
+// Not counted: below threshold.
+var simple = "hello \"world\""
+
+// Not counted: contains a backtick.
+var withBacktick = "use `backticks` with \\ \\ \\"
+
+func query() string {
+	// Counted: inside a function.
+	return "SELECT \"id\" FROM \"users\" WHERE \"name\" = $1"
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringunneeded
+
+// Bad: raw strings without special characters.
+var (
+	greeting = `hello` // want `raw string has no special characters; use a double-quoted string`
+	path     = `/api/v1/users` // want `raw string has no special characters; use a double-quoted string`
+)
+
+// Good: raw strings that need to be raw.
+var (
+	quoted    = `result="succeeded"`
+	pattern   = `^\d+$`
+	multiline = `first
+second`
+)
+
+// Bad: escaped double-quoted strings are still reported by the escape check.
+var escaped = "a=\"1\", b=\"2\"" // want `string has 4 escape sequences`
+
+// Good: marked to stay as it is.
+var kept = `hello` //attgo:keep-escaped matches the upstream source
+
+// Good: plain double-quoted strings.
+var plain = "hello"
+
+// Good: struct tags are conventionally raw strings.
+type config struct {
+	name string `yaml`
+}
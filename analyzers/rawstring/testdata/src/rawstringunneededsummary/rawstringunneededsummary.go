@@ -0,0 +1,16 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package rawstringunneededsummary // want `1 strings could be raw strings` `2 raw strings could be double-quoted strings`
+
+// Counted: raw strings without special characters.
+var (
+	greeting = `hello`
+	path     = `/api/v1/users`
+)
+
+// Not counted: marked to stay as it is.
+var kept = `plain` //attgo:keep-escaped
+
+// Counted: three escaped quotes.
+var json = "{\"a\": \"b\", \"c\": 1}"
@@ -0,0 +1,246 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package readonlyreturn provides an analyzer that detects exported functions returning slice or map fields directly.
+package readonlyreturn
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_readonly_return"
+	doc          = `detects exported functions returning slice or map fields directly
+
+A slice or map returned from a struct field shares its backing storage with
+the struct, so a caller that modifies the result changes the struct's
+internal state behind its back. Exported functions and methods should
+return a copy.
+
+Only returned field selections of slice or map type are reported. Returns
+inside function literals are ignored.
+
+A suggested fix copies the field into a new value of the declared result
+type before returning it.
+
+Bad:
+    func (s *Service) Peers() []string {
+        return s.peers
+    }
+
+Good:
+    func (s *Service) Peers() []string {
+        out := make([]string, len(s.peers))
+        copy(out, s.peers)
+        return out
+    }`
+)
+
+// copyName is the name of the variable holding the copy in suggested fixes.
+// A numeric suffix is added when the name is already taken.
+const copyName = "out"
+
+// Analyzer is the readonly return analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			resultTypes := flattenResults(fn.Type.Results)
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.FuncLit:
+					// Returns in a function literal belong to the literal.
+					return false
+				case *ast.ReturnStmt:
+					checkReturn(pass, node, resultTypes)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// flattenResults returns the type expression of each result in a result list,
+// repeating the type for results declared together, as in (a, b []T).
+func flattenResults(results *ast.FieldList) []ast.Expr {
+	if results == nil {
+		return nil
+	}
+
+	var exprs []ast.Expr
+	for _, field := range results.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+
+		for range count {
+			exprs = append(exprs, field.Type)
+		}
+	}
+
+	return exprs
+}
+
+// checkReturn reports results of a return statement that are slice or map fields.
+func checkReturn(pass *analysis.Pass, ret *ast.ReturnStmt, resultTypes []ast.Expr) {
+	// Names of the copies declared by fixes for earlier results, so that
+	// applying all fixes does not declare a name twice.
+	var copyNames []string
+
+	for i, result := range ret.Results {
+		sel, ok := ast.Unparen(result).(*ast.SelectorExpr)
+		if !ok {
+			continue
+		}
+
+		if !isCollectionField(pass, sel) {
+			continue
+		}
+
+		diag := analysis.Diagnostic{
+			Pos:     sel.Pos(),
+			Message: fmt.Sprintf("returning field %q exposes internal state; return a copy", sel.Sel.Name),
+		}
+
+		if len(resultTypes) == len(ret.Results) && isMakeable(pass, resultTypes[i]) {
+			if name, ok := copyVarName(pass, ret, copyNames); ok {
+				copyNames = append(copyNames, name)
+				diag.SuggestedFixes = copyFix(pass, ret, result, resultTypes[i], name)
+			}
+		}
+
+		pass.Report(diag)
+	}
+}
+
+// isCollectionField checks if a selector selects a struct field of slice or map type.
+func isCollectionField(pass *analysis.Pass, sel *ast.SelectorExpr) bool {
+	selection, ok := pass.TypesInfo.Selections[sel]
+	if !ok || selection.Kind() != types.FieldVal {
+		return false
+	}
+
+	switch selection.Type().Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// isMakeable checks if a result type is a slice or map type that make can create.
+func isMakeable(pass *analysis.Pass, expr ast.Expr) bool {
+	typ := pass.TypesInfo.TypeOf(expr)
+	if typ == nil {
+		return false
+	}
+
+	switch typ.Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	default:
+		return false
+	}
+}
+
+// copyVarName returns a name for the copy variable that can be declared
+// before a return statement without shadowing or clashing with another name,
+// including those taken by other fixes to the same statement.
+func copyVarName(pass *analysis.Pass, ret *ast.ReturnStmt, taken []string) (string, bool) {
+	scope := pass.Pkg.Scope().Innermost(ret.Pos())
+	if scope == nil {
+		return "", false
+	}
+
+	for n := 1; ; n++ {
+		name := copyName
+		if n > 1 {
+			name += strconv.Itoa(n)
+		}
+
+		if slices.Contains(taken, name) {
+			continue
+		}
+
+		if _, obj := scope.LookupParent(name, ret.Pos()); obj == nil {
+			return name, true
+		}
+	}
+}
+
+// copyFix builds a fix that copies a returned field into a new variable of the
+// declared result type and returns the copy instead.
+func copyFix(pass *analysis.Pass, ret *ast.ReturnStmt, result ast.Expr, resultType ast.Expr, name string) []analysis.SuggestedFix {
+	field := types.ExprString(ast.Unparen(result))
+	indent := strings.Repeat("\t", pass.Fset.Position(ret.Pos()).Column-1)
+
+	var lines []string
+	if _, ok := pass.TypesInfo.TypeOf(result).Underlying().(*types.Map); ok {
+		lines = []string{
+			fmt.Sprintf("%s := make(%s, len(%s))", name, types.ExprString(resultType), field),
+			fmt.Sprintf("for k, v := range %s {", field),
+			fmt.Sprintf("\t%s[k] = v", name),
+			"}",
+		}
+	} else {
+		lines = []string{
+			fmt.Sprintf("%s := make(%s, len(%s))", name, types.ExprString(resultType), field),
+			fmt.Sprintf("copy(%s, %s)", name, field),
+		}
+	}
+
+	var prefix strings.Builder
+	for _, line := range lines {
+		prefix.WriteString(line)
+		prefix.WriteString("\n")
+		prefix.WriteString(indent)
+	}
+
+	return []analysis.SuggestedFix{{
+		Message: "Return a copy of " + field,
+		TextEdits: []analysis.TextEdit{
+			{
+				Pos:     ret.Pos(),
+				End:     ret.Pos(),
+				NewText: []byte(prefix.String()),
+			},
+			{
+				Pos:     result.Pos(),
+				End:     result.End(),
+				NewText: []byte(name),
+			},
+		},
+	}}
+}
@@ -0,0 +1,85 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package readonlyreturn
+
+import "errors"
+
+// Peers is a named slice type.
+type Peers []string
+
+// Service holds internal state.
+type Service struct {
+	peers  []string
+	labels map[string]string
+	named  Peers
+	count  int
+	inner  *Service
+}
+
+// Peers returns the peers.
+func (s *Service) Peers() []string {
+	return s.peers // want `returning field "peers" exposes internal state; return a copy`
+}
+
+// Labels returns the labels.
+func (s *Service) Labels() map[string]string {
+	return s.labels // want `returning field "labels" exposes internal state; return a copy`
+}
+
+// Named returns the peers as a named type.
+func (s *Service) Named() Peers {
+	return s.named // want `returning field "named" exposes internal state; return a copy`
+}
+
+// InnerPeers returns the peers of the inner service.
+func (s *Service) InnerPeers() ([]string, error) {
+	if s.inner == nil {
+		return nil, errors.New("no inner service")
+	}
+
+	return (s.inner.peers), nil // want `returning field "peers" exposes internal state; return a copy`
+}
+
+// Both returns two fields, each copied under its own name.
+func (s *Service) Both() ([]string, map[string]string) {
+	return s.peers, s.labels // want `returning field "peers" exposes internal state; return a copy` `returning field "labels" exposes internal state; return a copy`
+}
+
+// Any returns the peers as an interface, which make cannot create.
+func (s *Service) Any() any {
+	return s.peers // want `returning field "peers" exposes internal state; return a copy`
+}
+
+// Shadowed already uses the name of the copy.
+func (s *Service) Shadowed() []string {
+	out := s.peers
+	_ = out
+
+	return s.peers // want `returning field "peers" exposes internal state; return a copy`
+}
+
+// Count returns a field that is not a slice or map.
+func (s *Service) Count() int {
+	return s.count
+}
+
+// Copied returns a copy.
+func (s *Service) Copied() []string {
+	out := make([]string, len(s.peers))
+	copy(out, s.peers)
+
+	return out
+}
+
+// Callback returns from a function literal, which is not checked.
+func (s *Service) Callback() func() []string {
+	return func() []string {
+		return s.peers
+	}
+}
+
+// allPeers is unexported, so it is not checked.
+func (s *Service) allPeers() []string {
+	return s.peers
+}
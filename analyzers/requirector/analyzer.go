@@ -0,0 +1,171 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package requirector provides an analyzer that checks exported structs needing construction have a constructor.
+package requirector
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/attestantio/attgo-linter/internal/servicetype"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_require_ctor"
+	doc          = `checks exported structs with unexported fields have a constructor
+
+An exported struct with unexported fields cannot be fully initialised by
+callers in other packages, so it should be created through a New<TypeName>
+or New function that returns it.
+
+Bad:
+    type Service struct {
+        log zerolog.Logger
+    }
+
+Good:
+    type Service struct {
+        log zerolog.Logger
+    }
+
+    func New(opts ...Option) (*Service, error)`
+)
+
+// NewAnalyzer creates a new require-ctor analyzer.
+// If servicesOnly is true, only service-like types such as Service or Client are checked.
+func NewAnalyzer(servicesOnly bool) *analysis.Analyzer {
+	r := &runner{
+		servicesOnly: servicesOnly,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	servicesOnly bool
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	constructed := constructedTypes(pass)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !typeSpec.Name.IsExported() {
+					continue
+				}
+
+				if r.servicesOnly && !servicetype.IsName(typeSpec.Name.Name) {
+					continue
+				}
+
+				typeName, ok := pass.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+				if !ok || constructed[typeName] || !hasUnexportedFields(typeName) {
+					continue
+				}
+
+				pass.Reportf(typeSpec.Name.Pos(), "exported type %q has unexported fields but no constructor",
+					typeSpec.Name.Name)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// constructedTypes returns the types returned by a New<TypeName> or New function in the package.
+// Both the declared result types and the types of returned values are considered, so a
+// constructor that returns its type as an interface is recognised.
+func constructedTypes(pass *analysis.Pass) map[*types.TypeName]bool {
+	constructed := make(map[*types.TypeName]bool)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv != nil || funcDecl.Type.Results == nil || funcDecl.Body == nil {
+				continue
+			}
+
+			var returned []types.Type
+			for _, result := range funcDecl.Type.Results.List {
+				returned = append(returned, pass.TypesInfo.TypeOf(result.Type))
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.FuncLit:
+					return false
+				case *ast.ReturnStmt:
+					for _, result := range node.Results {
+						returned = append(returned, pass.TypesInfo.TypeOf(result))
+					}
+				}
+
+				return true
+			})
+
+			for _, t := range returned {
+				named := namedType(t)
+				if named == nil {
+					continue
+				}
+
+				name := funcDecl.Name.Name
+				if name == "New" || name == "New"+named.Obj().Name() {
+					constructed[named.Obj()] = true
+				}
+			}
+		}
+	}
+
+	return constructed
+}
+
+// namedType returns the named type of t or of the type t points to, if any.
+func namedType(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, _ := t.(*types.Named)
+
+	return named
+}
+
+// hasUnexportedFields checks if a type is a struct with at least one unexported field.
+func hasUnexportedFields(typeName *types.TypeName) bool {
+	st, ok := typeName.Type().Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := range st.NumFields() {
+		if !st.Field(i).Exported() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package requirector
+
+import "errors"
+
+// Bad: unexported fields but no constructor.
+type Service struct { // want `exported type "Service" has unexported fields but no constructor`
+	name string
+}
+
+// Bad: a constructor with the wrong name does not count.
+type Cache struct { // want `exported type "Cache" has unexported fields but no constructor`
+	items map[string]int
+}
+
+func MakeCache() *Cache {
+	return &Cache{}
+}
+
+// Good: has a New<TypeName> constructor.
+type Client struct {
+	addr string
+}
+
+func NewClient(addr string) (*Client, error) {
+	if addr == "" {
+		return nil, errors.New("no address")
+	}
+
+	return &Client{addr: addr}, nil
+}
+
+// Good: has a New constructor returning a value.
+type Pool struct {
+	size int
+}
+
+func New() Pool {
+	return Pool{size: 1}
+}
+
+// Good: all fields are exported.
+type Config struct {
+	Name    string
+	Timeout int
+}
+
+// Good: unexported type.
+type worker struct {
+	id int
+}
+
+// Good: not a struct.
+type Handler func()
+
+// Runner is the interface returned by the Plugin constructor.
+type Runner interface {
+	Run()
+}
+
+// Good: the constructor returns the type as an interface.
+type Plugin struct {
+	cfg string
+}
+
+func (p *Plugin) Run() {}
+
+func NewPlugin() (Runner, error) {
+	return &Plugin{cfg: "default"}, nil
+}
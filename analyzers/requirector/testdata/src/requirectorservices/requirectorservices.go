@@ -0,0 +1,14 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package requirectorservices
+
+// Bad: service-like type without a constructor.
+type Service struct { // want `exported type "Service" has unexported fields but no constructor`
+	name string
+}
+
+// Good: not service-like, so not checked.
+type Cache struct {
+	items map[string]int
+}
@@ -0,0 +1,189 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retrybackoff provides an analyzer that detects hand-rolled sleep-retry loops.
+package retrybackoff
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_retry_backoff"
+	doc          = `detects manual sleep-retry loops that should use a backoff helper
+
+Retry loops built from time.Sleep differ in their delays, limits and
+cancellation handling. Use the shared backoff helper instead.
+
+A loop is reported when its body calls a function returning an error,
+checks an error in an if statement, and calls time.Sleep. Nested loops
+and function literals are considered separately.
+
+Bad:
+    for i := 0; i < 3; i++ {
+        if err = s.connect(); err == nil {
+            break
+        }
+        time.Sleep(time.Second)
+    }
+
+Good:
+    err := backoff.Retry(s.connect, backoff.NewExponentialBackOff())`
+)
+
+// NewAnalyzer creates a new retry backoff analyzer.
+// The helper, such as "backoff.Retry", is named in diagnostics, and loops
+// within the helper itself are not reported. It may be empty.
+func NewAnalyzer(helper string) *analysis.Analyzer {
+	r := &runner{
+		helper: helper,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	helper string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil || r.isHelper(pass, funcDecl) {
+				continue
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				var body *ast.BlockStmt
+
+				switch loop := n.(type) {
+				case *ast.ForStmt:
+					body = loop.Body
+				case *ast.RangeStmt:
+					body = loop.Body
+				default:
+					return true
+				}
+
+				if isSleepRetryLoop(pass, body) {
+					r.report(pass, n)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// isHelper checks if a function is the configured backoff helper.
+func (r *runner) isHelper(pass *analysis.Pass, fn *ast.FuncDecl) bool {
+	return r.helper != "" && fn.Recv == nil && pass.Pkg.Name()+"."+fn.Name.Name == r.helper
+}
+
+func (r *runner) report(pass *analysis.Pass, loop ast.Node) {
+	if r.helper == "" {
+		pass.Reportf(loop.Pos(), "use the backoff helper instead of a manual sleep-retry loop")
+
+		return
+	}
+
+	pass.Reportf(loop.Pos(), "use the backoff helper %s instead of a manual sleep-retry loop", r.helper)
+}
+
+// isSleepRetryLoop checks if a loop body calls a function returning an
+// error, checks an error and sleeps, ignoring nested loops and function literals.
+func isSleepRetryLoop(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var hasErrorCall, hasErrorCheck, hasSleep bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.FuncLit:
+			return false
+		case *ast.CallExpr:
+			if isSleepCall(pass, node) {
+				hasSleep = true
+			} else if returnsError(pass, node) {
+				hasErrorCall = true
+			}
+		case *ast.IfStmt:
+			if isErrorCheck(pass, node.Cond) {
+				hasErrorCheck = true
+			}
+		}
+
+		return true
+	})
+
+	return hasErrorCall && hasErrorCheck && hasSleep
+}
+
+// isSleepCall checks if a call is time.Sleep.
+func isSleepCall(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "time" {
+		return false
+	}
+
+	return fn.Name() == "Sleep"
+}
+
+// returnsError checks if a call returns an error as its last result.
+func returnsError(pass *analysis.Pass, call *ast.CallExpr) bool {
+	t := pass.TypesInfo.TypeOf(call)
+	if tuple, ok := t.(*types.Tuple); ok {
+		if tuple.Len() == 0 {
+			return false
+		}
+
+		t = tuple.At(tuple.Len() - 1).Type()
+	}
+
+	return t != nil && isErrorType(t)
+}
+
+// isErrorCheck checks if a condition compares an error with nil.
+func isErrorCheck(pass *analysis.Pass, cond ast.Expr) bool {
+	found := false
+
+	ast.Inspect(cond, func(n ast.Node) bool {
+		binary, ok := n.(*ast.BinaryExpr)
+		if !ok || (binary.Op != token.EQL && binary.Op != token.NEQ) {
+			return !found
+		}
+
+		if t := pass.TypesInfo.TypeOf(binary.X); t != nil && isErrorType(t) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isErrorType checks if a type is the built-in error interface.
+func isErrorType(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
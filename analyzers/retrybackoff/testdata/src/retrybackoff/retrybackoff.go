@@ -0,0 +1,81 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package retrybackoff
+
+import (
+	"errors"
+	"time"
+)
+
+func connect() error {
+	return errors.New("unavailable")
+}
+
+// Bad: counted retry loop with a sleep.
+func retryCounted() error {
+	var err error
+	for i := 0; i < 3; i++ { // want `use the backoff helper retrybackoff.Retry instead of a manual sleep-retry loop`
+		if err = connect(); err == nil {
+			return nil
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	return err
+}
+
+// Bad: infinite retry loop.
+func retryForever() {
+	for { // want `use the backoff helper retrybackoff.Retry instead of a manual sleep-retry loop`
+		err := connect()
+		if err == nil {
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// Good: polling loop without an error check.
+func poll(done func() bool) {
+	for !done() {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Good: retry loop without a sleep.
+func retryImmediately() error {
+	var err error
+	for range 3 {
+		if err = connect(); err == nil {
+			break
+		}
+	}
+
+	return err
+}
+
+// Good: the sleep is in a function literal, not the loop.
+func schedule() {
+	for range 3 {
+		if err := connect(); err != nil {
+			go func() {
+				time.Sleep(time.Second)
+			}()
+		}
+	}
+}
+
+// Retry is the backoff helper, which may sleep in a loop itself.
+func Retry(fn func() error) error {
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		time.Sleep(time.Second)
+	}
+}
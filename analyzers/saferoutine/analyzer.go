@@ -0,0 +1,208 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package saferoutine provides an analyzer that checks goroutines recover from panics.
+// An unrecovered panic in any goroutine terminates the whole process.
+package saferoutine
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_safe_routine"
+	doc          = `checks goroutines recover from panics or use the managed launcher
+
+A panic in a goroutine that is not recovered crashes the whole process.
+Goroutines started from a function literal must defer a function that
+calls recover(), or call the configured safe-launch helper.
+
+Bad:
+    go func() {
+        s.process(item)
+    }()
+
+Good:
+    go func() {
+        defer func() {
+            if r := recover(); r != nil {
+                s.log.Error().Interface("panic", r).Msg("Recovered from panic")
+            }
+        }()
+        s.process(item)
+    }()`
+)
+
+// NewAnalyzer creates a new safe-routine analyzer.
+// If launcher is not empty, a goroutine whose body calls the named helper
+// (for example "pool.Go") is considered safe.
+func NewAnalyzer(launcher string) *analysis.Analyzer {
+	r := &runner{
+		launcher: launcher,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	launcher string
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	recoverers := recoveringFuncs(pass)
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			goStmt, ok := n.(*ast.GoStmt)
+			if !ok {
+				return true
+			}
+
+			lit, ok := goStmt.Call.Fun.(*ast.FuncLit)
+			if !ok {
+				return true
+			}
+
+			if !r.isSafe(pass, lit.Body, recoverers) {
+				pass.Reportf(goStmt.Pos(), "goroutine should recover from panics or use the managed launcher")
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isSafe checks if a goroutine body defers a recovering function or calls the launcher.
+func (r *runner) isSafe(pass *analysis.Pass, body *ast.BlockStmt, recoverers map[*types.Func]bool) bool {
+	safe := false
+
+	inspectOwn(body, func(n ast.Node) {
+		switch node := n.(type) {
+		case *ast.DeferStmt:
+			switch fun := node.Call.Fun.(type) {
+			case *ast.FuncLit:
+				if callsRecover(pass, fun.Body) {
+					safe = true
+				}
+			default:
+				if fn, ok := typeutil.Callee(pass.TypesInfo, node.Call).(*types.Func); ok && recoverers[fn] {
+					safe = true
+				}
+			}
+		case *ast.CallExpr:
+			if r.isLauncher(pass, node) {
+				safe = true
+			}
+		}
+	})
+
+	return safe
+}
+
+// isLauncher checks if a call is to the configured safe-launch helper, matched
+// either by its source form (pool.Go) or by its package name and function name.
+func (r *runner) isLauncher(pass *analysis.Pass, call *ast.CallExpr) bool {
+	if r.launcher == "" {
+		return false
+	}
+
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if fun.Name == r.launcher {
+			return true
+		}
+	case *ast.SelectorExpr:
+		if x, ok := fun.X.(*ast.Ident); ok && x.Name+"."+fun.Sel.Name == r.launcher {
+			return true
+		}
+	}
+
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+
+	return fn.Pkg().Name()+"."+fn.Name() == r.launcher
+}
+
+// recoveringFuncs returns the functions declared in the package that call recover() directly.
+func recoveringFuncs(pass *analysis.Pass) map[*types.Func]bool {
+	funcs := make(map[*types.Func]bool)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			fn, ok := pass.TypesInfo.Defs[funcDecl.Name].(*types.Func)
+			if ok && callsRecover(pass, funcDecl.Body) {
+				funcs[fn] = true
+			}
+		}
+	}
+
+	return funcs
+}
+
+// callsRecover checks if a function body calls the recover builtin directly.
+func callsRecover(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	found := false
+
+	inspectOwn(body, func(n ast.Node) {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+
+		ident, ok := call.Fun.(*ast.Ident)
+		if !ok || ident.Name != "recover" {
+			return
+		}
+
+		if _, isBuiltin := pass.TypesInfo.ObjectOf(ident).(*types.Builtin); isBuiltin {
+			found = true
+		}
+	})
+
+	return found
+}
+
+// inspectOwn visits the nodes of a function body without descending into nested function literals,
+// as those run in a different frame.
+func inspectOwn(body *ast.BlockStmt, visit func(ast.Node)) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false
+		}
+
+		visit(n)
+
+		return true
+	})
+}
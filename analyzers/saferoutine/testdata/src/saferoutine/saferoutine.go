@@ -0,0 +1,78 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package saferoutine
+
+import "fmt"
+
+func process(item int) {
+	fmt.Println(item)
+}
+
+func handlePanic() {
+	if r := recover(); r != nil {
+		fmt.Println("recovered", r)
+	}
+}
+
+func worker() {}
+
+// Bad: no recovery.
+func StartUnsafe(items []int) {
+	for _, item := range items {
+		go func() { // want `goroutine should recover from panics or use the managed launcher`
+			process(item)
+		}()
+	}
+}
+
+// Bad: defer recover() does not stop a panic.
+func StartDeferRecover() {
+	go func() { // want `goroutine should recover from panics or use the managed launcher`
+		defer recover()
+		process(1)
+	}()
+}
+
+// Bad: recovery inside a nested goroutine does not protect the outer one.
+func StartNested() {
+	go func() { // want `goroutine should recover from panics or use the managed launcher`
+		go func() {
+			defer handlePanic()
+		}()
+		process(1)
+	}()
+}
+
+// Bad: deferred helper does not recover.
+func StartWrongHelper() {
+	go func() { // want `goroutine should recover from panics or use the managed launcher`
+		defer worker()
+		process(1)
+	}()
+}
+
+// Good: deferred function literal recovers.
+func StartDeferredLiteral() {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Println("recovered", r)
+			}
+		}()
+		process(1)
+	}()
+}
+
+// Good: deferred helper recovers.
+func StartDeferredHelper() {
+	go func() {
+		defer handlePanic()
+		process(1)
+	}()
+}
+
+// Good: named functions are not function literals.
+func StartNamed() {
+	go worker()
+}
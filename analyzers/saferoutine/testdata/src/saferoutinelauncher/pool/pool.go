@@ -0,0 +1,9 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package pool
+
+// Go runs fn with panic recovery.
+func Go(fn func()) {
+	fn()
+}
@@ -0,0 +1,32 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package saferoutinelauncher
+
+import (
+	"saferoutinelauncher/pool"
+	workers "saferoutinelauncher/pool"
+)
+
+func process() {}
+
+// Good: goroutine body uses the managed launcher.
+func StartLauncher() {
+	go func() {
+		pool.Go(process)
+	}()
+}
+
+// Good: launcher matched through its package name.
+func StartRenamedLauncher() {
+	go func() {
+		workers.Go(process)
+	}()
+}
+
+// Bad: no recovery and no launcher.
+func StartUnsafe() {
+	go func() { // want `goroutine should recover from panics or use the managed launcher`
+		process()
+	}()
+}
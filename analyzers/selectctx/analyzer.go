@@ -0,0 +1,126 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package selectctx provides an analyzer that checks select statements can be cancelled.
+package selectctx
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_select_ctx"
+	doc          = `checks select statements include a ctx.Done() case or a default
+
+A select that waits only on work channels blocks forever if those channels
+are never ready, which hangs shutdown. Include a case receiving from a
+context's Done() channel, or a default case for a non-blocking select.
+
+Bad:
+    select {
+    case item := <-s.queue:
+        s.process(item)
+    }
+
+Good:
+    select {
+    case <-ctx.Done():
+        return ctx.Err()
+    case item := <-s.queue:
+        s.process(item)
+    }`
+)
+
+// Analyzer is the select context analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			selectStmt, ok := n.(*ast.SelectStmt)
+			if !ok {
+				return true
+			}
+
+			// An empty select deliberately blocks forever.
+			if len(selectStmt.Body.List) == 0 {
+				return true
+			}
+
+			if !isCancellable(selectStmt) {
+				pass.Reportf(selectStmt.Pos(), "select should include a ctx.Done() case or default to avoid blocking forever")
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isCancellable checks if a select statement has a default case or a Done() receive.
+func isCancellable(selectStmt *ast.SelectStmt) bool {
+	for _, stmt := range selectStmt.Body.List {
+		clause, ok := stmt.(*ast.CommClause)
+		if !ok {
+			continue
+		}
+
+		// A nil Comm is the default case.
+		if clause.Comm == nil || isDoneReceive(clause.Comm) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDoneReceive checks if a communication receives from a Done() call,
+// as in `case <-ctx.Done():` or `case _, ok := <-ctx.Done():`.
+func isDoneReceive(comm ast.Stmt) bool {
+	var expr ast.Expr
+
+	switch stmt := comm.(type) {
+	case *ast.ExprStmt:
+		expr = stmt.X
+	case *ast.AssignStmt:
+		if len(stmt.Rhs) != 1 {
+			return false
+		}
+
+		expr = stmt.Rhs[0]
+	default:
+		return false
+	}
+
+	unary, ok := ast.Unparen(expr).(*ast.UnaryExpr)
+	if !ok || unary.Op != token.ARROW {
+		return false
+	}
+
+	call, ok := ast.Unparen(unary.X).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "Done"
+}
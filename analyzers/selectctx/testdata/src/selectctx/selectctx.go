@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package selectctx
+
+import (
+	"context"
+	"time"
+)
+
+type service struct {
+	queue chan int
+	done  chan struct{}
+}
+
+// Bad: blocks forever if the queue is never ready.
+func (s *service) blocking() int {
+	select { // want `select should include a ctx.Done\(\) case or default to avoid blocking forever`
+	case item := <-s.queue:
+		return item
+	case <-time.After(time.Second):
+		return 0
+	}
+}
+
+// Bad: receiving from a plain channel is not a Done() receive.
+func (s *service) plainDone() {
+	select { // want `select should include a ctx.Done\(\) case or default to avoid blocking forever`
+	case <-s.done:
+	case s.queue <- 1:
+	}
+}
+
+// Good: context-aware.
+func (s *service) contextAware(ctx context.Context) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case item := <-s.queue:
+		return item, nil
+	}
+}
+
+// Good: Done() receive with assignment.
+func (s *service) contextAssign(ctx context.Context) bool {
+	select {
+	case _, ok := <-ctx.Done():
+		return ok
+	case <-s.queue:
+		return true
+	}
+}
+
+// Good: non-blocking select.
+func (s *service) nonBlocking() bool {
+	select {
+	case s.queue <- 1:
+		return true
+	default:
+		return false
+	}
+}
+
+// Good: empty select deliberately blocks forever.
+func (s *service) forever() {
+	select {}
+}
@@ -0,0 +1,109 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sharedmutable provides an analyzer that detects exported functions returning package-level slices or maps.
+package sharedmutable
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_shared_mutable"
+	doc          = `detects exported functions returning package-level slices or maps
+
+A slice or map returned from a package-level variable is shared with every
+caller, so a caller that modifies the result changes the value seen by the
+package and by all other callers. Exported functions should return a copy
+or construct the value locally.
+
+Only returned identifiers that resolve to a package-level variable of slice
+or map type are reported. Returns inside function literals are ignored.
+
+Bad:
+    var defaults = []string{"a", "b"}
+
+    func Defaults() []string {
+        return defaults
+    }
+
+Good:
+    func Defaults() []string {
+        return slices.Clone(defaults)
+    }`
+)
+
+// Analyzer is the shared mutable analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch node := n.(type) {
+				case *ast.FuncLit:
+					// Returns in a function literal belong to the literal.
+					return false
+				case *ast.ReturnStmt:
+					checkReturn(pass, node)
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil, nil
+}
+
+// checkReturn reports results of a return statement that are package-level slices or maps.
+func checkReturn(pass *analysis.Pass, ret *ast.ReturnStmt) {
+	for _, result := range ret.Results {
+		ident, ok := ast.Unparen(result).(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		if isSharedMutable(pass, ident) {
+			pass.Reportf(ident.Pos(),
+				"returning shared package-level %q allows callers to mutate global state", ident.Name)
+		}
+	}
+}
+
+// isSharedMutable checks if an identifier refers to a package-level variable of slice or map type.
+func isSharedMutable(pass *analysis.Pass, ident *ast.Ident) bool {
+	v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok || v.Pkg() != pass.Pkg || v.Parent() != pass.Pkg.Scope() {
+		return false
+	}
+
+	switch v.Type().Underlying().(type) {
+	case *types.Slice, *types.Map:
+		return true
+	default:
+		return false
+	}
+}
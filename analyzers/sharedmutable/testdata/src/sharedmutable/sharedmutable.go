@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package sharedmutable
+
+import "slices"
+
+var defaults = []string{"a", "b"}
+
+var limits = map[string]int{"requests": 10}
+
+var names [2]string
+
+type Options []string
+
+var defaultOptions = Options{"verbose"}
+
+// Bad: returns a package-level slice.
+func Defaults() []string {
+	return defaults // want `returning shared package-level "defaults" allows callers to mutate global state`
+}
+
+// Bad: returns a package-level map.
+func Limits() map[string]int {
+	return (limits) // want `returning shared package-level "limits" allows callers to mutate global state`
+}
+
+// Bad: named slice types are slices too.
+func DefaultOptions() (Options, error) {
+	return defaultOptions, nil // want `returning shared package-level "defaultOptions" allows callers to mutate global state`
+}
+
+type Registry struct{}
+
+// Bad: exported methods are checked too.
+func (r *Registry) Limits() map[string]int {
+	return limits // want `returning shared package-level "limits" allows callers to mutate global state`
+}
+
+// Good: returns a copy.
+func DefaultsCopy() []string {
+	return slices.Clone(defaults)
+}
+
+// Good: returns a locally constructed value.
+func LocalDefaults() []string {
+	local := []string{"a", "b"}
+
+	return local
+}
+
+// Good: arrays are copied on return.
+func Names() [2]string {
+	return names
+}
+
+// Good: unexported functions are not part of the API.
+func defaultsRef() []string {
+	return defaults
+}
+
+// Good: returns inside function literals are not the function's results.
+func Visit(fn func([]string)) {
+	getter := func() []string {
+		return defaults
+	}
+	fn(getter())
+}
@@ -0,0 +1,104 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slicepointer provides an analyzer that detects pointers to slices and maps in exported functions.
+// Slices and maps already refer to their underlying storage, so a pointer to one is rarely needed.
+package slicepointer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_slice_pointer"
+	doc          = `detects pointers to slices and maps in exported function signatures
+
+Slices and maps are already reference types: passing one by value lets the
+callee read and modify its elements, so a *[]T or *map[K]V parameter or
+result adds an indirection, and a possible nil pointer, for no benefit.
+Return the new slice instead of assigning through a pointer to one.
+
+Parameters and results of exported functions and methods are checked.
+Pointers to arrays, such as *[32]byte, and to named slice and map types,
+which may have methods, are not reported.
+
+Bad:
+    func AppendItems(items *[]Item, extra ...Item) {
+        *items = append(*items, extra...)
+    }
+
+Good:
+    func AppendItems(items []Item, extra ...Item) []Item {
+        return append(items, extra...)
+    }`
+)
+
+// Analyzer is the slice pointer analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || !funcDecl.Name.IsExported() {
+				continue
+			}
+
+			checkFields(pass, funcDecl.Name.Name, funcDecl.Type.Params)
+			checkFields(pass, funcDecl.Name.Name, funcDecl.Type.Results)
+		}
+	}
+
+	return nil, nil
+}
+
+// checkFields reports the parameters or results in a field list whose type
+// is a pointer to a slice or map.
+func checkFields(pass *analysis.Pass, funcName string, fields *ast.FieldList) {
+	if fields == nil {
+		return
+	}
+
+	for _, field := range fields.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok || !isSliceOrMap(pass, star.X) {
+			continue
+		}
+
+		pass.Reportf(star.Pos(), "avoid pointer to slice/map in %q; slices and maps are already reference types", funcName)
+	}
+}
+
+// isSliceOrMap checks if an expression is a slice or map type literal.
+func isSliceOrMap(pass *analysis.Pass, expr ast.Expr) bool {
+	switch expr.(type) {
+	case *ast.ArrayType, *ast.MapType:
+	default:
+		return false
+	}
+
+	// Array type literals with a length, such as [32]byte, are arrays.
+	switch pass.TypesInfo.TypeOf(expr).(type) {
+	case *types.Slice, *types.Map:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package slicepointer
+
+type Item struct {
+	Name string
+}
+
+// Items is a named slice type.
+type Items []Item
+
+type Store struct {
+	items []Item
+}
+
+// Bad: pointer to slice parameter.
+func AppendItems(items *[]Item, extra ...Item) { // want `avoid pointer to slice/map in "AppendItems"; slices and maps are already reference types`
+	*items = append(*items, extra...)
+}
+
+// Bad: pointer to map parameter.
+func Merge(dst *map[string]int, src map[string]int) { // want `avoid pointer to slice/map in "Merge"; slices and maps are already reference types`
+	for k, v := range src {
+		(*dst)[k] = v
+	}
+}
+
+// Bad: pointer to slice result.
+func (s *Store) Items() *[]Item { // want `avoid pointer to slice/map in "Items"; slices and maps are already reference types`
+	return &s.items
+}
+
+// Bad: several results.
+func Split(text string) (*[]string, *map[string]bool, error) { // want `avoid pointer to slice/map in "Split"; slices and maps are already reference types` `avoid pointer to slice/map in "Split"; slices and maps are already reference types`
+	words := []string{text}
+	seen := map[string]bool{text: true}
+
+	return &words, &seen, nil
+}
+
+// Bad: pointer to slice of a generic element type.
+func Reset[T any](values *[]T) { // want `avoid pointer to slice/map in "Reset"; slices and maps are already reference types`
+	*values = nil
+}
+
+// Good: slice parameter and result.
+func Append(items []Item, extra ...Item) []Item {
+	return append(items, extra...)
+}
+
+// Good: pointer to array.
+func Hash(digest *[32]byte) {
+	digest[0] = 0
+}
+
+// Good: pointer to a named slice type, which may have methods.
+func Sort(items *Items) {
+	_ = items
+}
+
+// Good: unexported functions are not part of the API.
+func appendNames(names *[]string, name string) {
+	*names = append(*names, name)
+}
+
+// Good: a pointer to a slice nested in another type.
+func Lookup(index map[string]*[]Item) []Item {
+	return *index["all"]
+}
+
+var _ = appendNames
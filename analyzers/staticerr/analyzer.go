@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package staticerr provides an analyzer that checks static sentinel errors use errors.New.
+package staticerr
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_static_err"
+	doc          = `checks that static sentinel errors use errors.New
+
+Package-level errors whose message has no formatting verbs should be
+declared with errors.New rather than fmt.Errorf. fmt.Errorf without
+verbs does unnecessary work and suggests formatting that isn't there.
+
+Bad:
+    var ErrNotFound = fmt.Errorf("not found")
+
+Good:
+    var ErrNotFound = errors.New("not found")
+    var ErrBadSlot = fmt.Errorf("bad slot %d", maxSlot)`
+)
+
+// Analyzer is the static error analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+
+				for _, value := range valueSpec.Values {
+					call, ok := value.(*ast.CallExpr)
+					if !ok {
+						continue
+					}
+
+					if isStaticErrorf(pass, call) {
+						pass.Reportf(call.Pos(), "use errors.New for static error messages")
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// isStaticErrorf checks if a call is fmt.Errorf with a constant format and no verbs.
+func isStaticErrorf(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || fn.Name() != "Errorf" {
+		return false
+	}
+
+	// Additional arguments imply formatting, even if the verbs are missing.
+	if len(call.Args) != 1 {
+		return false
+	}
+
+	tv, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return false
+	}
+
+	return !hasFormatVerbs(constant.StringVal(tv.Value))
+}
+
+// hasFormatVerbs checks if a format string contains any verbs.
+// The literal percent escape "%%" is not counted as a verb.
+func hasFormatVerbs(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		if i+1 < len(format) && format[i+1] == '%' {
+			i++
+
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
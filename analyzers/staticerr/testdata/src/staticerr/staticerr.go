@@ -0,0 +1,48 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package staticerr
+
+import (
+	"errors"
+	"fmt"
+)
+
+const maxSlot = 32
+
+const notReadyMsg = "not ready"
+
+// Bad: fmt.Errorf without verbs.
+var ErrNotFound = fmt.Errorf("not found") // want `use errors.New for static error messages`
+
+// Bad: constant format without verbs.
+var ErrNotReady = fmt.Errorf(notReadyMsg) // want `use errors.New for static error messages`
+
+// Bad: escaped percent is not a verb.
+var ErrFull = fmt.Errorf("100%% full") // want `use errors.New for static error messages`
+
+// Bad: grouped declarations are also checked.
+var (
+	errClosed  = fmt.Errorf("closed") // want `use errors.New for static error messages`
+	errTimeout = errors.New("timeout")
+)
+
+// Good: errors.New for a static message.
+var ErrInvalid = errors.New("invalid")
+
+// Good: format string with verbs.
+var ErrBadSlot = fmt.Errorf("slot must be less than %d", maxSlot)
+
+// Good: wrapping another error.
+var ErrWrapped = fmt.Errorf("wrapped: %w", ErrInvalid)
+
+// Errorf is not fmt.Errorf.
+func Errorf(format string) error { return errors.New(format) }
+
+// Good: a different function named Errorf.
+var ErrLocal = Errorf("local")
+
+func build() error {
+	// Good: only package-level declarations are checked.
+	return fmt.Errorf("failed")
+}
@@ -17,8 +17,11 @@ package structfieldorder
 
 import (
 	"go/ast"
+	"go/types"
+	"slices"
 	"strings"
 
+	"github.com/attestantio/attgo-linter/internal/structtag"
 	"golang.org/x/tools/go/analysis"
 )
 
@@ -35,6 +38,24 @@ Struct fields should be ordered by category:
 
 This creates a predictable structure that makes code easier to navigate.
 
+Optionally, each misordered struct is reported once with the full
+suggested field order, rather than once per misplaced field. Fields in a
+category keep their declared order, and embedded fields stay after the
+field declared before them.
+
+Optionally, fields within a category that share a value for a configured
+struct tag key, such as group:"network", must be declared together.
+
+Optionally, dependency fields must have interface types rather than
+pointers to concrete structs, so that tests can substitute fakes.
+
+Optionally, exported fields must come before unexported fields of the
+same category.
+
+Optionally, a struct is reported if ordering its data fields by
+descending alignment would reduce its size. Fields of other categories
+keep their positions.
+
 Example:
     type Service struct {
         // Logger
@@ -57,11 +78,73 @@ Example:
     }`
 )
 
-// Analyzer is the struct field order analyzer.
-var Analyzer = &analysis.Analyzer{
-	Name: analyzerName,
-	Doc:  doc,
-	Run:  run,
+// Analyzer is the struct field order analyzer with default settings.
+var Analyzer = NewAnalyzer()
+
+// Option configures the struct field order analyzer.
+type Option func(*runner)
+
+// WithConsolidate sets whether a misordered struct is reported once, with
+// the suggested order of all its fields, instead of once per field.
+func WithConsolidate(consolidate bool) Option {
+	return func(r *runner) {
+		r.consolidate = consolidate
+	}
+}
+
+// WithGroupTag sets the struct tag key whose values group fields. Within a
+// category, fields with the same value must be contiguous. An empty key
+// disables the check.
+func WithGroupTag(groupTag string) Option {
+	return func(r *runner) {
+		r.groupTag = groupTag
+	}
+}
+
+// WithDepsAsInterfaces sets whether dependency fields whose type is a
+// pointer to a concrete struct are reported.
+func WithDepsAsInterfaces(depsAsInterfaces bool) Option {
+	return func(r *runner) {
+		r.depsAsInterfaces = depsAsInterfaces
+	}
+}
+
+// WithExportedFirst sets whether an unexported field may not be declared
+// before an exported field of the same category.
+func WithExportedFirst(exportedFirst bool) Option {
+	return func(r *runner) {
+		r.exportedFirst = exportedFirst
+	}
+}
+
+// WithOptimizePadding sets whether a struct is reported when reordering its
+// data fields by descending alignment would reduce its size.
+func WithOptimizePadding(optimizePadding bool) Option {
+	return func(r *runner) {
+		r.optimizePadding = optimizePadding
+	}
+}
+
+// NewAnalyzer creates a new struct field order analyzer with the given options.
+func NewAnalyzer(opts ...Option) *analysis.Analyzer {
+	r := &runner{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	consolidate      bool
+	groupTag         string
+	depsAsInterfaces bool
+	exportedFirst    bool
+	optimizePadding  bool
 }
 
 // fieldCategory represents the category of a struct field.
@@ -93,33 +176,87 @@ func (c fieldCategory) String() string {
 	}
 }
 
-func run(pass *analysis.Pass) (any, error) {
+func (r *runner) run(pass *analysis.Pass) (any, error) {
 	for _, file := range pass.Files {
-		for _, decl := range file.Decls {
-			genDecl, ok := decl.(*ast.GenDecl)
+		names := structNames(file)
+
+		// Each struct type node is visited once, whether it is named,
+		// nested in another struct or anonymous.
+		ast.Inspect(file, func(n ast.Node) bool {
+			structType, ok := n.(*ast.StructType)
 			if !ok {
-				continue
+				return true
 			}
 
-			for _, spec := range genDecl.Specs {
-				typeSpec, ok := spec.(*ast.TypeSpec)
-				if !ok {
-					continue
-				}
+			if r.consolidate {
+				checkStructFieldOrderConsolidated(pass, structType)
+			} else {
+				checkStructFieldOrder(pass, names[structType], structType)
+			}
 
-				structType, ok := typeSpec.Type.(*ast.StructType)
-				if !ok {
-					continue
-				}
+			if r.groupTag != "" {
+				checkFieldGroups(pass, structType, r.groupTag)
+			}
 
-				checkStructFieldOrder(pass, typeSpec.Name.Name, structType)
+			if r.depsAsInterfaces {
+				checkDependencyTypes(pass, structType)
 			}
-		}
+
+			if r.exportedFirst {
+				checkExportedFirst(pass, structType)
+			}
+
+			if r.optimizePadding {
+				checkPadding(pass, names[structType], structType)
+			}
+
+			return true
+		})
 	}
 
 	return nil, nil
 }
 
+// anonymousStructName names a struct type with no better name in diagnostics.
+const anonymousStructName = "anonymous"
+
+// structNames returns names for the struct types in a file, for use in
+// diagnostics. Declared types use their type name, struct types of fields
+// use the field name qualified by the name of the outer struct, as in
+// "Service.config", and struct types of variables use the variable name.
+// Other struct types, such as those of composite literals, are anonymous.
+func structNames(file *ast.File) map[*ast.StructType]string {
+	names := make(map[*ast.StructType]string)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.TypeSpec:
+			if st, ok := node.Type.(*ast.StructType); ok {
+				names[st] = node.Name.Name
+			}
+		case *ast.ValueSpec:
+			if st, ok := node.Type.(*ast.StructType); ok && len(node.Names) > 0 {
+				names[st] = node.Names[0].Name
+			}
+		case *ast.StructType:
+			if _, ok := names[node]; !ok {
+				names[node] = anonymousStructName
+			}
+
+			// Parents are visited before their children, so the outer name is known.
+			for _, field := range node.Fields.List {
+				if st, ok := field.Type.(*ast.StructType); ok && len(field.Names) > 0 {
+					names[st] = names[node] + "." + field.Names[0].Name
+				}
+			}
+		}
+
+		return true
+	})
+
+	return names
+}
+
 func checkStructFieldOrder(pass *analysis.Pass, structName string, st *ast.StructType) {
 	if st.Fields == nil || len(st.Fields.List) == 0 {
 		return
@@ -152,8 +289,270 @@ func checkStructFieldOrder(pass *analysis.Pass, structName string, st *ast.Struc
 	}
 }
 
+// categorizedField is a named struct field and its category.
+type categorizedField struct {
+	name     string
+	category fieldCategory
+}
+
+// checkStructFieldOrderConsolidated reports a misordered struct once, at
+// the struct, with the suggested order of all its fields. Embedded fields
+// are named by their type.
+func checkStructFieldOrderConsolidated(pass *analysis.Pass, st *ast.StructType) {
+	if st.Fields == nil || len(st.Fields.List) == 0 {
+		return
+	}
+
+	var fields []categorizedField
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			fields = append(fields, categorizedField{
+				name:     types.ExprString(field.Type),
+				category: categoryUnknown,
+			})
+
+			continue
+		}
+
+		for _, name := range field.Names {
+			fields = append(fields, categorizedField{
+				name:     name.Name,
+				category: categorizeField(name.Name, field.Type),
+			})
+		}
+	}
+
+	ordered := orderFields(fields)
+	if slices.Equal(ordered, fields) {
+		return
+	}
+
+	names := make([]string, 0, len(ordered))
+	for _, field := range ordered {
+		names = append(names, field.name)
+	}
+
+	pass.Reportf(st.Pos(), "fields should be ordered: %s", strings.Join(names, ", "))
+}
+
+// orderFields returns fields in category order. The order is stable, so
+// fields in the same category keep their relative order. Fields of unknown
+// category, such as embedded fields, stay directly after the nearest known
+// field declared before them, or at the start if there is none.
+func orderFields(fields []categorizedField) []categorizedField {
+	// Each run is a known field followed by the unknown fields after it,
+	// apart from a leading run of unknown fields.
+	var runs [][]categorizedField
+
+	for _, field := range fields {
+		if field.category == categoryUnknown && len(runs) > 0 {
+			runs[len(runs)-1] = append(runs[len(runs)-1], field)
+
+			continue
+		}
+
+		runs = append(runs, []categorizedField{field})
+	}
+
+	// A leading run of unknown fields has the lowest category, so stays first.
+	slices.SortStableFunc(runs, func(a, b []categorizedField) int {
+		return int(a[0].category) - int(b[0].category)
+	})
+
+	return slices.Concat(runs...)
+}
+
+// checkFieldGroups reports fields whose group tag value was already used by
+// an earlier field of the same category, but not by the field before them
+// in that category.
+func checkFieldGroups(pass *analysis.Pass, st *ast.StructType, groupTag string) {
+	if st.Fields == nil {
+		return
+	}
+
+	// The group of the previous field in each category, and the groups seen so far.
+	lastGroup := make(map[fieldCategory]string)
+	seenGroups := make(map[fieldCategory]map[string]bool)
+
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			continue // Embedded field.
+		}
+
+		group, _ := structtag.Lookup(field, groupTag)
+		cat := categorizeField(field.Names[0].Name, field.Type)
+
+		if group != "" && seenGroups[cat][group] && lastGroup[cat] != group {
+			pass.Reportf(field.Names[0].Pos(),
+				"field %q with %s tag %q should be next to the other fields in its group",
+				field.Names[0].Name, groupTag, group)
+		}
+
+		if seenGroups[cat] == nil {
+			seenGroups[cat] = make(map[string]bool)
+		}
+
+		seenGroups[cat][group] = true
+		lastGroup[cat] = group
+	}
+}
+
+// checkDependencyTypes reports dependency fields whose type is a pointer to
+// a concrete struct rather than an interface.
+func checkDependencyTypes(pass *analysis.Pass, st *ast.StructType) {
+	for _, field := range st.Fields.List {
+		ptr, ok := pass.TypesInfo.TypeOf(field.Type).(*types.Pointer)
+		if !ok {
+			continue
+		}
+
+		if _, ok := ptr.Elem().Underlying().(*types.Struct); !ok {
+			continue
+		}
+
+		for _, name := range field.Names {
+			if categorizeField(name.Name, field.Type) == categoryDependency {
+				pass.Reportf(name.Pos(), "dependency field %q should be an interface for testability", name.Name)
+			}
+		}
+	}
+}
+
+// checkExportedFirst reports exported fields declared after an unexported
+// field of the same category. Embedded fields are not checked.
+func checkExportedFirst(pass *analysis.Pass, st *ast.StructType) {
+	// The first unexported field of each category.
+	firstUnexported := make(map[fieldCategory]string)
+
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			cat := categorizeField(name.Name, field.Type)
+
+			if !name.IsExported() {
+				if _, exists := firstUnexported[cat]; !exists {
+					firstUnexported[cat] = name.Name
+				}
+
+				continue
+			}
+
+			if unexported, exists := firstUnexported[cat]; exists {
+				pass.Reportf(name.Pos(), "exported field %q should precede unexported %q within the %s group",
+					name.Name, unexported, cat)
+			}
+		}
+	}
+}
+
+// checkPadding reports a struct whose size would shrink if its data fields
+// were ordered by descending alignment. The data fields are reordered among
+// the positions they already occupy, so fields of other categories, and
+// embedded fields, stay where they are.
+func checkPadding(pass *analysis.Pass, structName string, st *ast.StructType) {
+	structure, ok := pass.TypesInfo.TypeOf(st).(*types.Struct)
+	if !ok || structure.NumFields() < 2 {
+		return
+	}
+
+	fields := make([]*types.Var, 0, structure.NumFields())
+	for field := range structure.Fields() {
+		// The size of a field whose type depends on a type parameter is not known.
+		if hasTypeParam(field.Type()) {
+			return
+		}
+
+		fields = append(fields, field)
+	}
+
+	// The positions of the data fields, in declaration order.
+	var slots []int
+
+	i := 0
+	for _, field := range st.Fields.List {
+		if len(field.Names) == 0 {
+			i++
+
+			continue // Embedded field.
+		}
+
+		for _, name := range field.Names {
+			if categorizeField(name.Name, field.Type) == categoryData {
+				slots = append(slots, i)
+			}
+
+			i++
+		}
+	}
+
+	if len(slots) < 2 || i != len(fields) {
+		return
+	}
+
+	sizes := pass.TypesSizes
+	if sizes == nil {
+		sizes = types.SizesFor("gc", "amd64")
+	}
+
+	data := make([]*types.Var, 0, len(slots))
+	for _, slot := range slots {
+		data = append(data, fields[slot])
+	}
+
+	slices.SortStableFunc(data, func(a, b *types.Var) int {
+		return int(sizes.Alignof(b.Type()) - sizes.Alignof(a.Type()))
+	})
+
+	reordered := slices.Clone(fields)
+	for j, slot := range slots {
+		reordered[slot] = data[j]
+	}
+
+	current := sizes.Sizeof(structure)
+
+	optimal := sizes.Sizeof(types.NewStruct(reordered, nil))
+	if optimal < current {
+		pass.Reportf(st.Pos(), "reordering data fields could reduce struct %q size from %d to %d bytes",
+			structName, current, optimal)
+	}
+}
+
+// hasTypeParam checks if the size of a type depends on a type parameter.
+// Pointers, slices, maps, channels and functions have a fixed size whatever
+// their element types.
+func hasTypeParam(typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Array:
+		return hasTypeParam(t.Elem())
+	case *types.Named:
+		for arg := range t.TypeArgs().Types() {
+			if hasTypeParam(arg) {
+				return true
+			}
+		}
+
+		return hasTypeParam(t.Underlying())
+	case *types.Struct:
+		for field := range t.Fields() {
+			if hasTypeParam(field.Type()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // categorizeField determines the category of a field based on name and type.
 func categorizeField(name string, typ ast.Expr) fieldCategory {
+	// Bool fields are flags, such as enableLogger or singleBlock, whatever
+	// their name ends with.
+	if ident, ok := typ.(*ast.Ident); ok && ident.Name == "bool" {
+		return categoryData
+	}
+
 	lowerName := strings.ToLower(name)
 
 	// Logger fields.
@@ -195,8 +594,16 @@ func categorizeField(name string, typ ast.Expr) fieldCategory {
 	return categoryData
 }
 
-// isSyncType checks if a type is from the sync package.
+// isSyncType checks if a type is from the sync or sync/atomic packages.
 func isSyncType(typ ast.Expr) bool {
+	// Unwrap generic instantiations such as atomic.Pointer[T].
+	switch t := typ.(type) {
+	case *ast.IndexExpr:
+		typ = t.X
+	case *ast.IndexListExpr:
+		typ = t.X
+	}
+
 	sel, ok := typ.(*ast.SelectorExpr)
 	if !ok {
 		return false
@@ -207,11 +614,24 @@ func isSyncType(typ ast.Expr) bool {
 		return false
 	}
 
-	if ident.Name == "sync" {
+	switch ident.Name {
+	case "sync":
 		switch sel.Sel.Name {
 		case "Mutex", "RWMutex", "WaitGroup", "Once", "Cond", "Pool", "Map":
 			return true
 		}
+	case "atomic":
+		return isAtomicTypeName(sel.Sel.Name)
+	}
+
+	return false
+}
+
+// isAtomicTypeName checks if a name is one of the sync/atomic package's types.
+func isAtomicTypeName(name string) bool {
+	switch name {
+	case "Bool", "Int32", "Int64", "Uint32", "Uint64", "Uintptr", "Pointer", "Value":
+		return true
 	}
 
 	return false
@@ -25,3 +25,43 @@ func TestAnalyzer(t *testing.T) {
 
 	analysistest.Run(t, testdata, structfieldorder.Analyzer, "structfieldorder")
 }
+
+func TestAnalyzerConsolidate(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := structfieldorder.NewAnalyzer(structfieldorder.WithConsolidate(true))
+
+	analysistest.Run(t, testdata, analyzer, "structfieldorderconsolidate")
+}
+
+func TestAnalyzerGroupTag(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := structfieldorder.NewAnalyzer(structfieldorder.WithGroupTag("group"))
+
+	analysistest.Run(t, testdata, analyzer, "structfieldordergroup")
+}
+
+func TestAnalyzerDepsAsInterfaces(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := structfieldorder.NewAnalyzer(structfieldorder.WithDepsAsInterfaces(true))
+
+	analysistest.Run(t, testdata, analyzer, "structfieldorderdeps")
+}
+
+func TestAnalyzerExportedFirst(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := structfieldorder.NewAnalyzer(structfieldorder.WithExportedFirst(true))
+
+	analysistest.Run(t, testdata, analyzer, "structfieldorderexported")
+}
+
+func TestAnalyzerOptimizePadding(t *testing.T) {
+	testdata := analysistest.TestData()
+
+	analyzer := structfieldorder.NewAnalyzer(structfieldorder.WithOptimizePadding(true))
+
+	analysistest.Run(t, testdata, analyzer, "structfieldorderpadding")
+}
@@ -3,7 +3,10 @@
 
 package structfieldorder
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 // GoodService has fields in the correct order.
 type GoodService struct {
@@ -54,3 +57,70 @@ type MixedOrder struct {
 	db      interface{}
 	metrics interface{} // want `field "metrics" \(metrics\) should come before "db" \(dependency\)`
 }
+
+// AtomicFirst has an atomic counter before data fields.
+type AtomicFirst struct {
+	count  atomic.Int64
+	name   string // want `field "name" \(data\) should come before "count" \(synchronization\)`
+	active atomic.Bool
+}
+
+// AtomicPointerFirst has a generic atomic pointer before data fields.
+type AtomicPointerFirst struct {
+	current atomic.Pointer[string]
+	config  interface{} // want `field "config" \(data\) should come before "current" \(synchronization\)`
+}
+
+// AtomicLast has atomic fields in the sync group.
+type AtomicLast struct {
+	log     interface{}
+	config  interface{}
+	mu      sync.Mutex
+	count   atomic.Uint64
+	current atomic.Pointer[string]
+}
+
+// NestedAnonymous has an out-of-order struct as the type of a field.
+type NestedAnonymous struct {
+	log    interface{}
+	worker struct {
+		mu     sync.Mutex
+		logger interface{} // want `field "logger" \(logger\) should come before "mu" \(synchronization\) in struct "NestedAnonymous.worker"`
+	}
+	config interface{}
+}
+
+// Outer structs are reported once even with nested structs inside them.
+type OuterOutOfOrder struct {
+	mu    sync.Mutex
+	inner struct { // want `field "inner" \(data\) should come before "mu" \(synchronization\) in struct "OuterOutOfOrder"`
+		log interface{}
+	}
+}
+
+// Anonymous structs in variables and composite literals are checked too.
+var settings struct {
+	wg  sync.WaitGroup
+	log interface{} // want `field "log" \(logger\) should come before "wg" \(synchronization\) in struct "settings"`
+}
+
+// FeatureFlags has bool fields that are data whatever their names.
+type FeatureFlags struct {
+	name         string
+	enableLogger bool
+	singleBlock  bool
+	timeout      int
+}
+
+func newWorker() {
+	// Local type declarations are checked.
+	type job struct {
+		done   chan struct{}
+		client interface{} // want `field "client" \(dependency\) should come before "done" \(synchronization\) in struct "job"`
+	}
+
+	_ = struct {
+		mu      sync.Mutex
+		metrics interface{} // want `field "metrics" \(metrics\) should come before "mu" \(synchronization\) in struct "anonymous"`
+	}{}
+}
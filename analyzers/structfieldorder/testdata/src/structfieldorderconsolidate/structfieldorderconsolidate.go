@@ -0,0 +1,67 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package structfieldorderconsolidate
+
+import "sync"
+
+// GoodService has fields in the correct order.
+type GoodService struct {
+	log     interface{}
+	metrics interface{}
+	client  interface{}
+	config  interface{}
+	mu      sync.Mutex
+}
+
+// BadService is reported once with the full order.
+type BadService struct { // want `fields should be ordered: log, metrics, client, config, mu`
+	mu      sync.Mutex
+	config  interface{}
+	client  interface{}
+	log     interface{}
+	metrics interface{}
+}
+
+// GroupedBad keeps the order of fields declared together.
+type GroupedBad struct { // want `fields should be ordered: logger, name, value, a, b`
+	name, value string
+	a, b        chan struct{}
+	logger      interface{}
+}
+
+// EmbeddedGood ignores embedded fields.
+type EmbeddedGood struct {
+	sync.Mutex
+	log  interface{}
+	name string
+}
+
+// StableBad keeps data fields in their declared order rather than sorting them by name.
+type StableBad struct { // want `fields should be ordered: log, zone, address, mode, mu`
+	zone    string
+	address string
+	mu      sync.Mutex
+	mode    int
+	log     interface{}
+}
+
+// Base is embedded in other structs.
+type Base struct{}
+
+// EmbeddedBad keeps embedded fields after the field declared before them.
+type EmbeddedBad struct { // want `fields should be ordered: log, Base, \*sync.WaitGroup, name, config, mu`
+	name   string
+	mu     sync.Mutex
+	log    interface{}
+	Base
+	*sync.WaitGroup
+	config interface{}
+}
+
+// LeadingEmbeddedBad keeps leading embedded fields at the start.
+type LeadingEmbeddedBad struct { // want `fields should be ordered: Base, log, name`
+	Base
+	name string
+	log  interface{}
+}
@@ -0,0 +1,21 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package structfieldorderdeps
+
+type Store interface {
+	Get(key string) ([]byte, error)
+}
+
+type HTTPClient struct{}
+
+type memoryCache struct{}
+
+type Service struct {
+	client  *HTTPClient  // want `dependency field "client" should be an interface for testability`
+	cache   *memoryCache // want `dependency field "cache" should be an interface for testability`
+	store   Store
+	handler func() error
+	db      HTTPClient
+	config  *HTTPClient
+}
@@ -0,0 +1,49 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package structfieldorderexported
+
+import "sync"
+
+type Logger struct{}
+
+type Base struct{}
+
+// Bad: mixed visibility in the data group.
+type Config struct {
+	value   string
+	Name    string // want `exported field "Name" should precede unexported "value" within the data group`
+	count   int
+	Timeout int // want `exported field "Timeout" should precede unexported "value" within the data group`
+}
+
+// Bad: multiple names in one field.
+type Pair struct {
+	left, Right int // want `exported field "Right" should precede unexported "left" within the data group`
+}
+
+// Good: exported fields first in each category.
+type Service struct {
+	log Logger
+
+	Name    string
+	Timeout int
+	value   string
+
+	Mu sync.Mutex
+	wg sync.WaitGroup
+}
+
+// Good: categories are compared separately.
+type Worker struct {
+	log   Logger
+	Name  string
+	state string
+	Mu    sync.Mutex
+}
+
+// Good: embedded fields are not checked.
+type Wrapper struct {
+	value string
+	Base
+}
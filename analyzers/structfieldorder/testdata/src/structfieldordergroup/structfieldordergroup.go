@@ -0,0 +1,31 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package structfieldordergroup
+
+import "sync"
+
+// GroupedConfig keeps each group together.
+type GroupedConfig struct {
+	host    string `group:"network"`
+	port    int    `group:"network"`
+	timeout int    `group:"limits"`
+	retries int    `group:"limits"`
+	name    string
+}
+
+// InterleavedConfig splits the network group.
+type InterleavedConfig struct {
+	host    string `group:"network"`
+	timeout int    `group:"limits"`
+	port    int    `group:"network"` // want `field "port" with group tag "network" should be next to the other fields in its group`
+	name    string
+	retries int `group:"limits"` // want `field "retries" with group tag "limits" should be next to the other fields in its group`
+}
+
+// CategoriesSeparate only compares fields within a category.
+type CategoriesSeparate struct {
+	client interface{} `group:"a"`
+	host   string      `group:"a"`
+	mu     sync.Mutex
+}
@@ -0,0 +1,55 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package structfieldorderpadding
+
+import "sync"
+
+// Bad: small data fields between int64s waste padding.
+type Packet struct { // want `reordering data fields could reduce struct "Packet" size from 40 to 24 bytes`
+	valid  bool
+	id     int64
+	count  int32
+	seq    int64
+	closed bool
+}
+
+// Good: data fields already ordered by alignment.
+type Frame struct {
+	id     int64
+	seq    int64
+	count  int32
+	valid  bool
+	closed bool
+}
+
+// Bad: the sync field stays last while the data fields before it move.
+type Session struct { // want `reordering data fields could reduce struct "Session" size from 40 to 32 bytes`
+	open    bool
+	started int64
+	retries int8
+	ended   int64
+	mu      sync.Mutex
+}
+
+// Bad: embedded fields keep their position while data fields around them move.
+type Tagged struct { // want `reordering data fields could reduce struct "Tagged" size from 48 to 40 bytes`
+	kind bool
+	Frame
+	id   int64
+	last bool
+}
+
+// Good: field sizes depend on the type parameter.
+type Pair[T any] struct {
+	ok    bool
+	value T
+	ready bool
+}
+
+// Bad: anonymous structs are checked too.
+var header struct { // want `reordering data fields could reduce struct "header" size from 24 to 16 bytes`
+	flag bool
+	size int64
+	last bool
+}
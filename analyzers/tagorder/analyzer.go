@@ -0,0 +1,171 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tagorder provides an analyzer that checks the order of struct tag keys.
+// Fields tagged for several encodings are easier to scan when the keys always appear in the same order.
+package tagorder
+
+import (
+	"go/ast"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/attestantio/attgo-linter/internal/structtag"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_tag_order"
+	doc          = `checks that struct tag keys appear in the configured order
+
+When a field carries several tags, such as json, yaml and validate, the
+keys should always appear in the same order so that tags can be compared
+at a glance across fields and types.
+
+Keys that are not in the configured order may appear anywhere; only the
+relative order of configured keys is checked. A fix reorders the tag.
+
+Bad:
+    type Config struct {
+        Name string ` + "`yaml:\"name\" json:\"name\"`" + `
+    }
+
+Good:
+    type Config struct {
+        Name string ` + "`json:\"name\" yaml:\"name\"`" + `
+    }`
+)
+
+// NewAnalyzer creates a new tag-order analyzer for the given key order.
+func NewAnalyzer(order []string) *analysis.Analyzer {
+	r := &runner{
+		ranks: make(map[string]int, len(order)),
+	}
+	for i, key := range order {
+		if _, exists := r.ranks[key]; !exists {
+			r.ranks[key] = i
+		}
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	ranks map[string]int
+}
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				if field.Tag != nil {
+					r.checkTag(pass, field.Tag)
+				}
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// checkTag reports a struct tag whose configured keys are out of order.
+func (r *runner) checkTag(pass *analysis.Pass, lit *ast.BasicLit) {
+	tag, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return
+	}
+
+	entries, ok := structtag.Parse(tag)
+	if !ok {
+		return
+	}
+
+	// Collect the positions of configured keys; others stay where they are.
+	var positions []int
+	for i, entry := range entries {
+		if _, ok := r.ranks[entry.Key]; ok {
+			positions = append(positions, i)
+		}
+	}
+
+	ordered := make([]structtag.Entry, len(positions))
+	for i, pos := range positions {
+		ordered[i] = entries[pos]
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return r.ranks[ordered[i].Key] < r.ranks[ordered[j].Key]
+	})
+
+	fixed := make([]structtag.Entry, len(entries))
+	copy(fixed, entries)
+
+	inOrder := true
+	for i, pos := range positions {
+		if fixed[pos] != ordered[i] {
+			inOrder = false
+		}
+		fixed[pos] = ordered[i]
+	}
+
+	if inOrder {
+		return
+	}
+
+	keys := make([]string, len(ordered))
+	for i, entry := range ordered {
+		keys[i] = entry.Key
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     lit.Pos(),
+		End:     lit.End(),
+		Message: "struct tag keys should be ordered " + strings.Join(keys, ", "),
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "Reorder struct tag keys",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     lit.Pos(),
+				End:     lit.End(),
+				NewText: []byte(tagLiteral(lit.Value, fixed)),
+			}},
+		}},
+	})
+}
+
+// tagLiteral returns a struct tag literal holding entries, quoted in the
+// same style as the original literal.
+func tagLiteral(original string, entries []structtag.Entry) string {
+	parts := make([]string, len(entries))
+	for i, entry := range entries {
+		parts[i] = entry.String()
+	}
+
+	tag := strings.Join(parts, " ")
+	if strings.HasPrefix(original, "`") && !strings.Contains(tag, "`") {
+		return "`" + tag + "`"
+	}
+
+	return strconv.Quote(tag)
+}
@@ -0,0 +1,36 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package tagorder
+
+// Bad: keys out of order.
+type Config struct {
+	Name    string `yaml:"name" json:"name"`                                     // want `struct tag keys should be ordered json, yaml`
+	Port    int    `validate:"required" yaml:"port" json:"port"`                 // want `struct tag keys should be ordered json, yaml, validate`
+	Timeout string `json:"timeout" validate:"required" yaml:"timeout,omitempty"` // want `struct tag keys should be ordered json, yaml, validate`
+	Quoted  string "yaml:\"quoted\" json:\"quoted\""                             // want `struct tag keys should be ordered json, yaml`
+}
+
+// Bad: unconfigured keys keep their place.
+type Record struct {
+	ID string `db:"id" yaml:"id" json:"id"` // want `struct tag keys should be ordered json, yaml`
+}
+
+// Good: keys in order.
+type Options struct {
+	Name   string `json:"name" yaml:"name" validate:"required"`
+	Level  int    `json:"level" validate:"min=1"`
+	Extra  string `json:"extra" db:"extra" yaml:"extra"`
+	Single string `yaml:"single"`
+	Plain  string
+}
+
+// Good: malformed tags are left to go vet.
+type Malformed struct {
+	Value string `yaml:value json:"value"`
+}
+
+// Bad: anonymous structs are checked too.
+var inline = struct {
+	Enabled bool `yaml:"enabled" json:"enabled"` // want `struct tag keys should be ordered json, yaml`
+}{}
@@ -0,0 +1,119 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testpkg provides an analyzer that suggests black-box test packages.
+package testpkg
+
+import (
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_test_pkg"
+	doc          = `suggests the external _test package for black-box tests
+
+Test files that only use the exported API of a package should be in the
+external foo_test package, so that they exercise the package the way its
+callers do. A test file stays in the internal package if it uses any
+unexported identifier declared in another file, or if another file uses an
+unexported identifier it declares.
+
+Bad:
+    package foo
+
+    func TestParse(t *testing.T) {
+        Parse("x") // Only exported API used.
+    }
+
+Good:
+    package foo_test`
+)
+
+// Analyzer is the test package analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	// Black-box tests cannot import package main.
+	if pass.Pkg.Name() == "main" {
+		return nil, nil
+	}
+
+	// External test packages are already black-box tests.
+	if strings.HasSuffix(pass.Pkg.Name(), "_test") {
+		return nil, nil
+	}
+
+	// Files that use unexported identifiers declared elsewhere, and files
+	// that declare unexported identifiers used elsewhere, are both tied to
+	// the internal package.
+	internal := make(map[string]bool)
+
+	for ident, obj := range pass.TypesInfo.Uses {
+		if obj.Pkg() != pass.Pkg || obj.Exported() || !obj.Pos().IsValid() {
+			continue
+		}
+
+		useFile := pass.Fset.Position(ident.Pos()).Filename
+		declFile := pass.Fset.Position(obj.Pos()).Filename
+
+		if useFile != declFile {
+			internal[useFile] = true
+			internal[declFile] = true
+		}
+	}
+
+	for _, file := range pass.Files {
+		filename := pass.Fset.Position(file.Package).Filename
+		if !strings.HasSuffix(filename, "_test.go") || internal[filename] {
+			continue
+		}
+
+		if !usesPackage(pass, file) {
+			continue
+		}
+
+		pass.Reportf(file.Name.Pos(), "consider black-box test package %q", file.Name.Name+"_test")
+	}
+
+	return nil, nil
+}
+
+// usesPackage checks if a file uses any identifier declared in another file of the package.
+func usesPackage(pass *analysis.Pass, file *ast.File) bool {
+	filename := pass.Fset.Position(file.Package).Filename
+	found := false
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok || found {
+			return !found
+		}
+
+		obj := pass.TypesInfo.Uses[ident]
+		if obj != nil && obj.Pkg() == pass.Pkg && obj.Pos().IsValid() &&
+			pass.Fset.Position(obj.Pos()).Filename != filename {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,19 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg // want `consider black-box test package "testpkg_test"`
+
+import "testing"
+
+// Bad: only the exported API is used.
+func TestParse(t *testing.T) {
+	cfg := Config{Name: "x"}
+	if Parse(cfg.Name) != "x" {
+		t.Fatal("unexpected result")
+	}
+}
+
+// Local unexported helpers do not tie the file to the internal package.
+func helper() string {
+	return "x"
+}
@@ -0,0 +1,17 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg_test
+
+import (
+	"testing"
+
+	"testpkg"
+)
+
+// Good: already a black-box test.
+func TestExternal(t *testing.T) {
+	if testpkg.Parse("z") != "z" {
+		t.Fatal("unexpected result")
+	}
+}
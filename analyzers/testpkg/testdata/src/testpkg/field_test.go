@@ -0,0 +1,14 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg
+
+import "testing"
+
+// Good: uses an unexported field.
+func TestTimeout(t *testing.T) {
+	cfg := Config{timeout: 1}
+	if cfg.timeout != 1 {
+		t.Fatal("unexpected result")
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg
+
+import "testing"
+
+// Good: uses an unexported function.
+func TestNormalise(t *testing.T) {
+	if normalise("x") != "x" {
+		t.Fatal("unexpected result")
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg
+
+import "testing"
+
+// Good: declares a helper used by another internal test file.
+func sharedValue() string {
+	return Parse("y")
+}
+
+func TestShared(t *testing.T) {
+	if sharedValue() != "y" {
+		t.Fatal("unexpected result")
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg
+
+// Parse is exported.
+func Parse(s string) string {
+	return normalise(s)
+}
+
+func normalise(s string) string {
+	return s
+}
+
+// Config is exported.
+type Config struct {
+	Name    string
+	timeout int
+}
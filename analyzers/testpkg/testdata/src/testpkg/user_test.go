@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkg
+
+import "testing"
+
+// Good: uses a helper declared in another internal test file.
+func TestUser(t *testing.T) {
+	if sharedValue() != "y" {
+		t.Fatal("unexpected result")
+	}
+}
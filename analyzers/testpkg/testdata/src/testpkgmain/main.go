@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package main
+
+// Run is exported.
+func Run() int {
+	return 0
+}
+
+func main() {
+	Run()
+}
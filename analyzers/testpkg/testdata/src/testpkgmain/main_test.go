@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package main
+
+import "testing"
+
+// Good: package main cannot be imported by an external test package.
+func TestRun(t *testing.T) {
+	if Run() != 0 {
+		t.Fatal("unexpected result")
+	}
+}
@@ -0,0 +1,16 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkgxtest_test
+
+import "testpkgxtest"
+
+// Good: an exported helper shared by the external test package.
+func ParseAll(values ...string) []string {
+	results := make([]string, 0, len(values))
+	for _, value := range values {
+		results = append(results, testpkgxtest.Parse(value))
+	}
+
+	return results
+}
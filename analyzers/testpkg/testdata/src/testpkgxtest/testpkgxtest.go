@@ -0,0 +1,9 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkgxtest
+
+// Parse is exported.
+func Parse(s string) string {
+	return s
+}
@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package testpkgxtest_test
+
+import "testing"
+
+// Good: external test files already are the black-box test package.
+func TestParseAll(t *testing.T) {
+	if len(ParseAll("a", "b")) != 2 {
+		t.Fatal("unexpected result")
+	}
+}
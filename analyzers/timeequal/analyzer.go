@@ -0,0 +1,134 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package timeequal provides an analyzer that detects time.Time values compared with == or !=.
+package timeequal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_time_equal"
+	doc          = `detects time.Time values compared with == or !=
+
+The == operator compares the fields of time.Time, including its monotonic
+clock reading and location, so two values for the same instant can compare
+unequal. The Equal method compares instants only.
+
+A suggested fix rewrites a == b to a.Equal(b) and a != b to !a.Equal(b).
+
+Bad:
+    if deadline == now {
+
+Good:
+    if deadline.Equal(now) {`
+)
+
+// Analyzer is the time equal analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			expr, ok := n.(*ast.BinaryExpr)
+			if !ok || (expr.Op != token.EQL && expr.Op != token.NEQ) {
+				return true
+			}
+
+			if isTime(pass.TypesInfo.TypeOf(expr.X)) && isTime(pass.TypesInfo.TypeOf(expr.Y)) {
+				pass.Report(analysis.Diagnostic{
+					Pos:            expr.OpPos,
+					Message:        "compare time.Time with .Equal, not ==",
+					SuggestedFixes: equalFix(expr),
+				})
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// isTime checks if a type is time.Time.
+func isTime(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == "time" && obj.Name() == "Time"
+}
+
+// equalFix returns a fix rewriting a comparison to a call to Equal.
+func equalFix(expr *ast.BinaryExpr) []analysis.SuggestedFix {
+	prefix := ""
+	if expr.Op == token.NEQ {
+		prefix = "!"
+	}
+
+	operator := ".Equal("
+	if needsParens(expr.X) {
+		prefix += "("
+		operator = ")" + operator
+	}
+
+	var edits []analysis.TextEdit
+	if prefix != "" {
+		edits = append(edits, analysis.TextEdit{
+			Pos:     expr.X.Pos(),
+			End:     expr.X.Pos(),
+			NewText: []byte(prefix),
+		})
+	}
+
+	edits = append(edits,
+		analysis.TextEdit{
+			Pos:     expr.X.End(),
+			End:     expr.Y.Pos(),
+			NewText: []byte(operator),
+		},
+		analysis.TextEdit{
+			Pos:     expr.Y.End(),
+			End:     expr.Y.End(),
+			NewText: []byte(")"),
+		},
+	)
+
+	return []analysis.SuggestedFix{{
+		Message:   "Use time.Time.Equal",
+		TextEdits: edits,
+	}}
+}
+
+// needsParens checks if an expression must be parenthesised before a method
+// call can be appended to it.
+func needsParens(expr ast.Expr) bool {
+	switch expr.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.CallExpr, *ast.IndexExpr, *ast.ParenExpr, *ast.CompositeLit:
+		return false
+	default:
+		return true
+	}
+}
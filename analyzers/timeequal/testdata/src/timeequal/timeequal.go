@@ -0,0 +1,41 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package timeequal
+
+import "time"
+
+type event struct {
+	at time.Time
+}
+
+func compare(a, b time.Time, e *event, p *time.Time, events []event) bool {
+	if a == b { // want `compare time.Time with .Equal, not ==`
+		return true
+	}
+
+	if a != b { // want `compare time.Time with .Equal, not ==`
+		return false
+	}
+
+	if e.at == time.Now() { // want `compare time.Time with .Equal, not ==`
+		return true
+	}
+
+	if *p != events[0].at { // want `compare time.Time with .Equal, not ==`
+		return false
+	}
+
+	// Good: comparing pointers compares identity, not instants.
+	if p == &a {
+		return true
+	}
+
+	// Good: already uses Equal.
+	if a.Equal(b) {
+		return true
+	}
+
+	// Good: unrelated types.
+	return a.Unix() == b.Unix()
+}
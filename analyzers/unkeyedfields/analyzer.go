@@ -0,0 +1,157 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package unkeyedfields provides an analyzer that flags unkeyed struct literals.
+package unkeyedfields
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_unkeyed_fields"
+	doc          = `flags unkeyed struct literals for structs with many fields
+
+Struct literals that populate many fields positionally are fragile: adding,
+removing or reordering fields silently changes their meaning. Use keyed
+fields instead.
+
+Bad:
+    cfg := Config{"localhost", 8080, true, 30}
+
+Good:
+    cfg := Config{Host: "localhost", Port: 8080, TLS: true, Timeout: 30}
+
+Standard library types are not checked, as positional literals are
+conventional for some of them. Further packages can be excluded by
+configuration.`
+)
+
+// NewAnalyzer creates a new unkeyed-fields analyzer.
+// Literals with more than threshold positional fields are reported, unless
+// the struct type is declared in one of ignorePackages or their subpackages.
+func NewAnalyzer(threshold int, ignorePackages []string) *analysis.Analyzer {
+	r := &runner{
+		threshold:      threshold,
+		ignorePackages: ignorePackages,
+	}
+
+	return &analysis.Analyzer{
+		Name: analyzerName,
+		Doc:  doc,
+		Run:  r.run,
+	}
+}
+
+type runner struct {
+	threshold      int
+	ignorePackages []string
+}
+
+// standardPackages returns the import paths of the standard library, as
+// listed by the go command on first use.
+var standardPackages = sync.OnceValues(func() (map[string]bool, error) {
+	out, err := exec.Command("go", "list", "std").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standard library packages: %w", err)
+	}
+
+	packages := make(map[string]bool)
+	for _, path := range strings.Fields(string(out)) {
+		packages[path] = true
+	}
+
+	return packages, nil
+})
+
+func (r *runner) run(pass *analysis.Pass) (any, error) {
+	std, err := standardPackages()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			r.checkCompositeLit(pass, lit, std)
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+func (r *runner) checkCompositeLit(pass *analysis.Pass, lit *ast.CompositeLit, std map[string]bool) {
+	if len(lit.Elts) <= r.threshold {
+		return
+	}
+
+	// Go requires either all or no elements to be keyed, so checking the
+	// first element is sufficient.
+	if _, keyed := lit.Elts[0].(*ast.KeyValueExpr); keyed {
+		return
+	}
+
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(lit)).(*types.Named)
+	if !ok {
+		return
+	}
+
+	if _, isStruct := named.Underlying().(*types.Struct); !isStruct {
+		return
+	}
+
+	if r.isIgnoredPackage(pass.Pkg, named.Obj().Pkg(), std) {
+		return
+	}
+
+	pass.Reportf(lit.Pos(), "use keyed fields for struct literal of %s", named.Obj().Name())
+}
+
+// isIgnoredPackage checks if a struct type's package should be skipped.
+// Types from the package under analysis are always checked; types from the
+// standard library or one of the configured ignored packages are not.
+func (r *runner) isIgnoredPackage(current *types.Package, pkg *types.Package, std map[string]bool) bool {
+	if pkg == nil {
+		return true
+	}
+
+	if pkg == current {
+		return false
+	}
+
+	path := pkg.Path()
+	if std[path] {
+		return true
+	}
+
+	for _, ignored := range r.ignorePackages {
+		if path == ignored || strings.HasPrefix(path, ignored+"/") {
+			return true
+		}
+	}
+
+	return false
+}
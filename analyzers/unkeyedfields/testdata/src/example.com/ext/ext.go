@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package ext is a mock third-party package for testing.
+package ext
+
+// Options is a third-party struct with many fields.
+type Options struct {
+	Name    string
+	Retries int
+	Verbose bool
+	Timeout int
+}
@@ -0,0 +1,14 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package models is in a module whose path has no dot, like a standard
+// library package.
+package models
+
+// Record has many fields.
+type Record struct {
+	ID    int
+	Name  string
+	Owner string
+	Size  int
+}
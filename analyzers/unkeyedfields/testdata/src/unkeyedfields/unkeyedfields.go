@@ -0,0 +1,68 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package unkeyedfields
+
+import (
+	"net"
+
+	"example.com/ext"
+	"myapp/models"
+)
+
+// Config has many fields.
+type Config struct {
+	Host    string
+	Port    int
+	TLS     bool
+	Timeout int
+}
+
+// Point has few fields.
+type Point struct {
+	X int
+	Y int
+}
+
+// Alias is an alias of Config.
+type Alias = Config
+
+func examples() {
+	// Bad: positional fields above the threshold.
+	_ = Config{"localhost", 8080, true, 30} // want `use keyed fields for struct literal of Config`
+
+	// Bad: pointer literal.
+	_ = &Config{"localhost", 8080, true, 30} // want `use keyed fields for struct literal of Config`
+
+	// Bad: elided type in a slice literal.
+	_ = []Config{
+		{"localhost", 8080, true, 30}, // want `use keyed fields for struct literal of Config`
+	}
+
+	// Bad: alias of a local struct.
+	_ = Alias{"localhost", 8080, true, 30} // want `use keyed fields for struct literal of Config`
+
+	// Bad: third-party type not in the ignore list.
+	_ = ext.Options{"name", 3, false, 10} // want `use keyed fields for struct literal of Options`
+
+	// Bad: type from a module path without a dot.
+	_ = models.Record{1, "name", "owner", 10} // want `use keyed fields for struct literal of Record`
+
+	// Good: keyed fields.
+	_ = Config{Host: "localhost", Port: 8080, TLS: true, Timeout: 30}
+
+	// Good: partially populated keyed literal.
+	_ = Config{Host: "localhost"}
+
+	// Good: few positional fields.
+	_ = Point{1, 2}
+
+	// Good: standard library type.
+	_ = net.SRV{"target", 1, 2, 3}
+
+	// Good: anonymous struct.
+	_ = struct{ A, B, C, D int }{1, 2, 3, 4}
+
+	// Good: slices are not structs.
+	_ = []int{1, 2, 3, 4, 5}
+}
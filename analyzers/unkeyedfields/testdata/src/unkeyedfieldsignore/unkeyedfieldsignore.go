@@ -0,0 +1,22 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package unkeyedfieldsignore
+
+import "example.com/ext"
+
+// Config has many fields.
+type Config struct {
+	Host    string
+	Port    int
+	TLS     bool
+	Timeout int
+}
+
+func examples() {
+	// Bad: local types are always checked.
+	_ = Config{"localhost", 8080, true, 30} // want `use keyed fields for struct literal of Config`
+
+	// Good: package is in the ignore list.
+	_ = ext.Options{"name", 3, false, 10}
+}
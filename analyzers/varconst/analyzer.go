@@ -0,0 +1,135 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package varconst provides an analyzer that detects exported variables that could be constants.
+package varconst
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	analyzerName = "attgo_var_const"
+	doc          = `detects exported package-level variables that could be constants
+
+An exported variable can be changed by any importing package. If it holds a
+constant value and is never reassigned within its own package, it should be
+declared as a const so that it cannot be changed by accident.
+
+A variable counts as reassigned if it is assigned, incremented or
+decremented, or has its address taken anywhere in the package.
+
+Bad:
+    var DefaultTimeout = 30 * time.Second
+
+Good:
+    const DefaultTimeout = 30 * time.Second`
+)
+
+// Analyzer is the var const analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	reassigned := reassignedVars(pass)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				checkSpec(pass, spec.(*ast.ValueSpec), reassigned)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// checkSpec reports the exported variables in a spec that are initialised
+// to a constant value and never reassigned.
+func checkSpec(pass *analysis.Pass, spec *ast.ValueSpec, reassigned map[*types.Var]bool) {
+	// A multi-value initialiser such as a function call cannot be constant.
+	if len(spec.Values) != len(spec.Names) {
+		return
+	}
+
+	for i, name := range spec.Names {
+		if !name.IsExported() {
+			continue
+		}
+
+		v, ok := pass.TypesInfo.Defs[name].(*types.Var)
+		if !ok || reassigned[v] {
+			continue
+		}
+
+		if _, ok := v.Type().Underlying().(*types.Basic); !ok {
+			continue
+		}
+
+		if tv, ok := pass.TypesInfo.Types[spec.Values[i]]; !ok || tv.Value == nil {
+			continue
+		}
+
+		pass.Reportf(name.Pos(), "exported var %q is never reassigned; consider const", name.Name)
+	}
+}
+
+// reassignedVars returns the package-level variables that are assigned,
+// incremented, decremented or have their address taken in the package.
+func reassignedVars(pass *analysis.Pass) map[*types.Var]bool {
+	reassigned := make(map[*types.Var]bool)
+
+	mark := func(expr ast.Expr) {
+		ident, ok := ast.Unparen(expr).(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		if v, ok := pass.TypesInfo.Uses[ident].(*types.Var); ok && v.Parent() == pass.Pkg.Scope() {
+			reassigned[v] = true
+		}
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.AssignStmt:
+				for _, lhs := range node.Lhs {
+					mark(lhs)
+				}
+			case *ast.IncDecStmt:
+				mark(node.X)
+			case *ast.UnaryExpr:
+				if node.Op == token.AND {
+					mark(node.X)
+				}
+			}
+
+			return true
+		})
+	}
+
+	return reassigned
+}
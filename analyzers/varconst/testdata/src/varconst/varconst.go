@@ -0,0 +1,63 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package varconst
+
+import (
+	"errors"
+	"flag"
+	"time"
+)
+
+// Bad: constant values that are never reassigned.
+var DefaultTimeout = 30 * time.Second // want `exported var "DefaultTimeout" is never reassigned; consider const`
+
+var (
+	MaxRetries = 3         // want `exported var "MaxRetries" is never reassigned; consider const`
+	Name       = "service" // want `exported var "Name" is never reassigned; consider const`
+)
+
+var MinPeers, MaxPeers = 1, 50 // want `exported var "MinPeers" is never reassigned; consider const` `exported var "MaxPeers" is never reassigned; consider const`
+
+// Good: reassigned within the package.
+var Verbose = false
+
+var Counter = 0
+
+var Limit = 10
+
+// Good: address taken, so may be changed through a pointer.
+var Port = 8080
+
+// Good: not a constant value.
+var StartTime = time.Now()
+
+// Good: not a basic type.
+var ErrNotFound = errors.New("not found")
+
+var Defaults = map[string]int{"a": 1}
+
+// Good: no initial value.
+var Mode string
+
+// Good: unexported variables are not checked.
+var defaultName = "worker"
+
+// Bad: assigning a local variable of the same name does not count.
+var Shadowed = 1 // want `exported var "Shadowed" is never reassigned; consider const`
+
+func init() {
+	flag.IntVar(&Port, "port", Port, "port to listen on")
+}
+
+func configure() {
+	Verbose = true
+	Counter++
+	(Limit) += 5
+
+	Shadowed := 2
+	Shadowed = 3
+	_ = Shadowed
+	_ = defaultName
+	_ = Mode
+}
@@ -0,0 +1,113 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wgadd provides an analyzer that checks WaitGroup.Add is called before starting a goroutine.
+package wgadd
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_wg_add"
+	doc          = `checks sync.WaitGroup.Add is not called inside the goroutine it counts
+
+Calling Add inside a goroutine races with Wait: the goroutine may not have
+run by the time Wait is called, in which case Wait returns early. Add must
+be called before the go statement.
+
+Only function literals launched directly by a go statement are checked.
+
+Bad:
+    go func() {
+        wg.Add(1)
+        defer wg.Done()
+        work()
+    }()
+
+Good:
+    wg.Add(1)
+    go func() {
+        defer wg.Done()
+        work()
+    }()`
+)
+
+// Analyzer is the WaitGroup add analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			goStmt, ok := n.(*ast.GoStmt)
+			if !ok {
+				return true
+			}
+
+			if lit, ok := ast.Unparen(goStmt.Call.Fun).(*ast.FuncLit); ok {
+				checkGoroutine(pass, lit)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+// checkGoroutine reports WaitGroup.Add calls in the body of a goroutine.
+func checkGoroutine(pass *analysis.Pass, lit *ast.FuncLit) {
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			// Nested literals run when called, which may be before a goroutine starts.
+			return false
+		case *ast.CallExpr:
+			if isWaitGroupAdd(pass, node) {
+				pass.Reportf(node.Pos(), "wg.Add must be called before launching the goroutine")
+			}
+		}
+
+		return true
+	})
+}
+
+// isWaitGroupAdd checks if a call is the Add method of sync.WaitGroup.
+func isWaitGroupAdd(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Name() != "Add" || fn.Pkg() == nil || fn.Pkg().Path() != "sync" {
+		return false
+	}
+
+	recv := fn.Signature().Recv()
+	if recv == nil {
+		return false
+	}
+
+	recvType := recv.Type()
+	if ptr, ok := recvType.(*types.Pointer); ok {
+		recvType = ptr.Elem()
+	}
+
+	named, ok := recvType.(*types.Named)
+
+	return ok && named.Obj().Name() == "WaitGroup"
+}
@@ -0,0 +1,79 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package wgadd
+
+import "sync"
+
+type worker struct {
+	wg sync.WaitGroup
+}
+
+func work() {}
+
+// Bad: Add inside the goroutine.
+func bad() {
+	var wg sync.WaitGroup
+
+	go func() {
+		wg.Add(1) // want `wg.Add must be called before launching the goroutine`
+		defer wg.Done()
+		work()
+	}()
+
+	wg.Wait()
+}
+
+// Bad: Add on a field, through a pointer.
+func (w *worker) start() {
+	go func() {
+		w.wg.Add(1) // want `wg.Add must be called before launching the goroutine`
+		defer w.wg.Done()
+		work()
+	}()
+}
+
+// Bad: Add nested in a statement inside the goroutine.
+func badLoop(wg *sync.WaitGroup, items []int) {
+	go func() {
+		for range items {
+			wg.Add(1) // want `wg.Add must be called before launching the goroutine`
+		}
+	}()
+}
+
+// Good: Add before the go statement.
+func good() {
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		work()
+	}()
+
+	wg.Wait()
+}
+
+// Not reported: Add in a nested function literal.
+func goodNested() {
+	var wg sync.WaitGroup
+
+	go func() {
+		inner := func() {
+			wg.Add(1)
+		}
+		inner()
+	}()
+}
+
+type counter struct{}
+
+func (c *counter) Add(n int) {}
+
+// Good: Add on another type.
+func goodOther(c *counter) {
+	go func() {
+		c.Add(1)
+	}()
+}
@@ -0,0 +1,196 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wrapexternal provides an analyzer that checks errors from other packages are wrapped.
+package wrapexternal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	analyzerName = "attgo_wrap_external"
+	doc          = `checks errors from other packages are wrapped before being returned
+
+An error returned verbatim from a call into another package loses the
+context of what this package was doing, which makes failures hard to
+trace. Wrap it with fmt.Errorf and %w.
+
+Only the direct pattern is checked: an error variable assigned from a
+call into another package and then returned unchanged. Calls within the
+same package, and errors created by the errors and fmt packages, are
+allowed.
+
+Bad:
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, err
+    }
+
+Good:
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read config: %w", err)
+    }`
+)
+
+// Analyzer is the wrap external analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  doc,
+	Run:  run,
+}
+
+// errorAssignment is an assignment of an error variable from a call.
+type errorAssignment struct {
+	pos    token.Pos
+	callee *types.Func
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			checkBody(pass, funcDecl.Body)
+		}
+	}
+
+	return nil, nil
+}
+
+// checkBody reports returns of errors that were last assigned from a call
+// into another package.
+func checkBody(pass *analysis.Pass, body *ast.BlockStmt) {
+	assignments := make(map[types.Object][]errorAssignment)
+
+	// Assignments and returns are visited in source order, so the last
+	// recorded assignment before a return is the one that reaches it
+	// in straight-line code.
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch stmt := n.(type) {
+		case *ast.AssignStmt:
+			recordAssignment(pass, stmt, assignments)
+		case *ast.ReturnStmt:
+			for _, result := range stmt.Results {
+				checkResult(pass, result, assignments)
+			}
+		}
+
+		return true
+	})
+}
+
+// recordAssignment records error variables assigned from a single call.
+// Any other assignment, such as err = fmt.Errorf(...), is also recorded
+// with no callee so that it replaces an earlier external assignment.
+func recordAssignment(pass *analysis.Pass, stmt *ast.AssignStmt, assignments map[types.Object][]errorAssignment) {
+	var callee *types.Func
+
+	if len(stmt.Rhs) == 1 {
+		if call, ok := stmt.Rhs[0].(*ast.CallExpr); ok {
+			callee, _ = typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+		}
+	}
+
+	for _, lhs := range stmt.Lhs {
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		obj := pass.TypesInfo.ObjectOf(ident)
+		if obj == nil || !isErrorType(obj.Type()) {
+			continue
+		}
+
+		assignments[obj] = append(assignments[obj], errorAssignment{
+			pos:    stmt.Pos(),
+			callee: callee,
+		})
+	}
+}
+
+// checkResult reports a returned error variable whose most recent
+// assignment was from a call into another package.
+func checkResult(pass *analysis.Pass, result ast.Expr, assignments map[types.Object][]errorAssignment) {
+	ident, ok := result.(*ast.Ident)
+	if !ok {
+		return
+	}
+
+	obj := pass.TypesInfo.Uses[ident]
+	if obj == nil {
+		return
+	}
+
+	var last *errorAssignment
+
+	for i := range assignments[obj] {
+		if assignments[obj][i].pos < result.Pos() {
+			last = &assignments[obj][i]
+		}
+	}
+
+	if last == nil || !isExternal(pass, last.callee) {
+		return
+	}
+
+	pass.Reportf(ident.Pos(), "error from %s should be wrapped with context, e.g. fmt.Errorf(\"...: %%w\", %s)",
+		calleeName(last.callee), ident.Name)
+}
+
+// isExternal checks if a function is declared in another package and is
+// not one of the standard error constructors.
+func isExternal(pass *analysis.Pass, fn *types.Func) bool {
+	if fn == nil || fn.Pkg() == nil || fn.Pkg() == pass.Pkg {
+		return false
+	}
+
+	switch fn.Pkg().Path() {
+	case "errors", "fmt":
+		return false
+	}
+
+	return true
+}
+
+// calleeName returns a short name for a function, such as os.Open or sql.DB.Query.
+func calleeName(fn *types.Func) string {
+	sig, ok := fn.Type().(*types.Signature)
+	if ok && sig.Recv() != nil {
+		recv := sig.Recv().Type()
+		if ptr, ok := recv.(*types.Pointer); ok {
+			recv = ptr.Elem()
+		}
+
+		if named, ok := recv.(*types.Named); ok {
+			return fn.Pkg().Name() + "." + named.Obj().Name() + "." + fn.Name()
+		}
+	}
+
+	return fn.Pkg().Name() + "." + fn.Name()
+}
+
+// isErrorType checks if a type is the built-in error interface.
+func isErrorType(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
@@ -0,0 +1,20 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+// Package store is a mock external package for testing.
+package store
+
+import "errors"
+
+// Get fetches a value.
+func Get(key string) (string, error) {
+	return "", errors.New("not found")
+}
+
+// DB is a mock database.
+type DB struct{}
+
+// Query runs a query.
+func (d *DB) Query(q string) error {
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package wrapexternal
+
+import (
+	"errors"
+	"fmt"
+
+	"wrapexternal/store"
+)
+
+// Bad: external error returned verbatim.
+func fetch(key string) (string, error) {
+	value, err := store.Get(key)
+	if err != nil {
+		return "", err // want `error from store.Get should be wrapped with context, e.g. fmt.Errorf\("...: %w", err\)`
+	}
+
+	return value, nil
+}
+
+// Bad: external method error in an if initialiser.
+func query(db *store.DB) error {
+	if err := db.Query("select"); err != nil {
+		return err // want `error from store.DB.Query should be wrapped with context`
+	}
+
+	return nil
+}
+
+// Good: wrapped with context.
+func fetchWrapped(key string) (string, error) {
+	value, err := store.Get(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch %s: %w", key, err)
+	}
+
+	return value, nil
+}
+
+// Good: same-package call.
+func local() error {
+	if err := helper(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func helper() error {
+	return errors.New("failed")
+}
+
+// Good: error reassigned after the external call.
+func reassigned(key string) error {
+	_, err := store.Get(key)
+	if err != nil {
+		err = fmt.Errorf("failed to get: %w", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// Good: errors package constructors.
+func constructed() error {
+	err := errors.New("failed")
+
+	return err
+}
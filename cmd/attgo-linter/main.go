@@ -0,0 +1,341 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command attgo-linter runs the attgo analyzers without golangci-lint.
+//
+// Usage:
+//
+//	attgo-linter [-settings file.json] [-fail-on error|warning|any] [-analyzers name,...] [-write-baseline file.json] [-concurrency N] [packages]
+//
+// With -analyzers, only the named analyzers are run, whether or not the
+// settings enable them.
+//
+// With -write-baseline, all current findings are written to the named
+// baseline file instead of being printed, ignoring any baseline_file in the
+// settings. Setting baseline_file to the same file then suppresses them.
+//
+// With -concurrency, at most N packages are analyzed at once. The default
+// is GOMAXPROCS. Findings are printed in file and line order whatever the
+// concurrency.
+//
+// If the settings set profile_analyzers, the time each analyzer spent is
+// written to standard error, or to profile_file, once analysis finishes.
+//
+// The exit code is 0 if no findings at or above the -fail-on severity were
+// reported, 3 if there were, and 1 if the analysis itself failed.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	attgolinter "github.com/attestantio/attgo-linter"
+	"github.com/attestantio/attgo-linter/internal/baseline"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	exitOK       = 0
+	exitFailure  = 1
+	exitFindings = 3
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("attgo-linter", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	settingsFile := flags.String("settings", "", "path to a JSON file containing plugin settings")
+	failOnFlag := flags.String("fail-on", "any", "minimum finding severity that causes a non-zero exit: error, warning or any")
+	analyzersFlag := flags.String("analyzers", "", "comma-separated names of the only analyzers to run, such as attgo_raw_string")
+	writeBaselineFlag := flags.String("write-baseline", "", "path of a baseline file to write with the current findings")
+	concurrencyFlag := flags.Int("concurrency", runtime.GOMAXPROCS(0), "maximum number of packages to analyze at once")
+
+	if err := flags.Parse(args); err != nil {
+		return exitFailure
+	}
+
+	failOn, err := parseSeverityThreshold(*failOnFlag)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	if *concurrencyFlag < 1 {
+		fmt.Fprintf(stderr, "concurrency %d must be at least 1\n", *concurrencyFlag)
+
+		return exitFailure
+	}
+
+	var analyzerNames []string
+	if *analyzersFlag != "" {
+		analyzerNames = strings.Split(*analyzersFlag, ",")
+	}
+
+	plugin, err := loadPlugin(*settingsFile, *writeBaselineFlag != "")
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	findings, err := analyzePackages(plugin, analyzerNames, flags.Args(), *concurrencyFlag)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	if err := plugin.WriteProfile(stderr); err != nil {
+		fmt.Fprintln(stderr, err)
+
+		return exitFailure
+	}
+
+	if *writeBaselineFlag != "" {
+		if err := baseline.Write(*writeBaselineFlag, baselineEntries(findings)); err != nil {
+			fmt.Fprintln(stderr, err)
+
+			return exitFailure
+		}
+
+		fmt.Fprintf(stderr, "wrote %d findings to %s\n", len(findings), *writeBaselineFlag)
+
+		return exitOK
+	}
+
+	for _, f := range findings {
+		fmt.Fprintf(stdout, "%s: %s [%s, %s]\n", f.Posn, f.Message, f.Analyzer, f.Severity)
+	}
+
+	return exitCode(findings, failOn)
+}
+
+// loadPlugin creates the plugin configured by a settings file.
+// If ignoreBaseline is true, findings in the settings' baseline file are reported too.
+func loadPlugin(settingsFile string, ignoreBaseline bool) (*attgolinter.Plugin, error) {
+	settings, err := loadSettings(settingsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if m, ok := settings.(map[string]any); ok && ignoreBaseline {
+		delete(m, "baseline_file")
+	}
+
+	plugin, err := attgolinter.New(settings)
+	if err != nil {
+		return nil, fmt.Errorf("invalid settings: %w", err)
+	}
+
+	return plugin.(*attgolinter.Plugin), nil
+}
+
+// analyzePackages loads the packages matching patterns and runs the plugin's analyzers over them.
+// If analyzerNames is not empty, only the named analyzers are run.
+// At most concurrency packages are analyzed at once.
+func analyzePackages(plugin *attgolinter.Plugin, analyzerNames []string, patterns []string, concurrency int) ([]finding, error) {
+	analyzers, err := buildAnalyzers(plugin, analyzerNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build analyzers: %w", err)
+	}
+
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+
+	if packages.PrintErrors(pkgs) > 0 {
+		return nil, errors.New("failed to load packages")
+	}
+
+	findings, err := analyzeConcurrently(analyzers, pkgs, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	sortFindings(findings)
+
+	return findings, nil
+}
+
+// analyzeConcurrently runs analyzers over each package using a pool of at
+// most concurrency workers, and returns the findings of all packages.
+func analyzeConcurrently(analyzers []*analysis.Analyzer, pkgs []*packages.Package, concurrency int) ([]finding, error) {
+	// Each worker writes only the results of the packages it takes, so no
+	// locking is needed.
+	results := make([][]finding, len(pkgs))
+	errs := make([]error, len(pkgs))
+
+	// The queue holds every package up front, so workers that stop after a
+	// panic cannot block the sender.
+	jobs := make(chan int, len(pkgs))
+	for i := range pkgs {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	for range min(concurrency, len(pkgs)) {
+		wg.Add(1)
+		go func() {
+			current := 0
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					errs[current] = fmt.Errorf("%s: analysis panicked: %v", pkgs[current].PkgPath, r)
+				}
+			}()
+
+			for i := range jobs {
+				current = i
+				results[i], errs[i] = analyzePackage(analyzers, pkgs[i])
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	var findings []finding
+	for i := range pkgs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+
+		findings = append(findings, results[i]...)
+	}
+
+	return findings, nil
+}
+
+// analyzePackage runs analyzers over a single package and its dependencies
+// and returns the findings in the package.
+func analyzePackage(analyzers []*analysis.Analyzer, pkg *packages.Package) ([]finding, error) {
+	graph, err := checker.Analyze(analyzers, []*packages.Package{pkg}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze packages: %w", err)
+	}
+
+	var findings []finding
+
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			return nil, fmt.Errorf("%s: %w", act, act.Err)
+		}
+
+		for _, d := range act.Diagnostics {
+			findings = append(findings, finding{
+				Analyzer: act.Analyzer.Name,
+				Posn:     act.Package.Fset.Position(d.Pos),
+				Message:  d.Message,
+				Severity: analyzerSeverity(act.Analyzer.Name),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// buildAnalyzers returns the named analyzers, or the enabled analyzers if no names are given.
+func buildAnalyzers(plugin *attgolinter.Plugin, names []string) ([]*analysis.Analyzer, error) {
+	if len(names) == 0 {
+		return plugin.BuildAnalyzers()
+	}
+
+	return plugin.BuildAnalyzersFor(names...)
+}
+
+// baselineEntries returns the baseline entries recording a set of findings.
+func baselineEntries(findings []finding) []baseline.Entry {
+	entries := make([]baseline.Entry, 0, len(findings))
+	for _, f := range findings {
+		entries = append(entries, baseline.Entry{
+			Analyzer: f.Analyzer,
+			File:     f.Posn.Filename,
+			Message:  f.Message,
+		})
+	}
+
+	return entries
+}
+
+// loadSettings reads plugin settings from a JSON file.
+// An empty filename returns nil settings, which selects the defaults.
+func loadSettings(filename string) (any, error) {
+	if filename == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read settings file: %w", err)
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("failed to parse settings file: %w", err)
+	}
+
+	return settings, nil
+}
+
+// sortFindings sorts findings by position, then analyzer and message, for stable output.
+func sortFindings(findings []finding) {
+	sort.Slice(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if a.Posn.Filename != b.Posn.Filename {
+			return a.Posn.Filename < b.Posn.Filename
+		}
+		if a.Posn.Line != b.Posn.Line {
+			return a.Posn.Line < b.Posn.Line
+		}
+		if a.Posn.Column != b.Posn.Column {
+			return a.Posn.Column < b.Posn.Column
+		}
+
+		if a.Analyzer != b.Analyzer {
+			return a.Analyzer < b.Analyzer
+		}
+
+		return a.Message < b.Message
+	})
+}
+
+// exitCode returns the process exit code for a set of findings given the
+// minimum severity that should cause a failure.
+func exitCode(findings []finding, failOn severity) int {
+	for _, f := range findings {
+		if f.Severity >= failOn {
+			return exitFindings
+		}
+	}
+
+	return exitOK
+}
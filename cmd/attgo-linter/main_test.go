@@ -0,0 +1,222 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/token"
+	"io"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	attgolinter "github.com/attestantio/attgo-linter"
+	"github.com/attestantio/attgo-linter/internal/baseline"
+)
+
+func TestExitCode(t *testing.T) {
+	info := finding{Analyzer: "attgo_struct_field_order", Severity: severityInfo}
+	warning := finding{Analyzer: "attgo_raw_string", Severity: severityWarning}
+	errorFinding := finding{Analyzer: "attgo_enum_iota", Severity: severityError}
+
+	tests := []struct {
+		name     string
+		findings []finding
+		failOn   string
+		expected int
+	}{
+		{
+			name:     "NoFindings",
+			failOn:   "any",
+			expected: exitOK,
+		},
+		{
+			name:     "AnyInfo",
+			findings: []finding{info},
+			failOn:   "any",
+			expected: exitFindings,
+		},
+		{
+			name:     "WarningInfo",
+			findings: []finding{info},
+			failOn:   "warning",
+			expected: exitOK,
+		},
+		{
+			name:     "WarningWarning",
+			findings: []finding{info, warning},
+			failOn:   "warning",
+			expected: exitFindings,
+		},
+		{
+			name:     "WarningError",
+			findings: []finding{errorFinding},
+			failOn:   "warning",
+			expected: exitFindings,
+		},
+		{
+			name:     "ErrorWarning",
+			findings: []finding{info, warning},
+			failOn:   "error",
+			expected: exitOK,
+		},
+		{
+			name:     "ErrorError",
+			findings: []finding{warning, errorFinding},
+			failOn:   "error",
+			expected: exitFindings,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			failOn, err := parseSeverityThreshold(test.failOn)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if code := exitCode(test.findings, failOn); code != test.expected {
+				t.Errorf("got exit code %d, want %d", code, test.expected)
+			}
+		})
+	}
+}
+
+func TestParseSeverityThresholdInvalid(t *testing.T) {
+	if _, err := parseSeverityThreshold("fatal"); err == nil {
+		t.Errorf("expected an error for an invalid threshold")
+	}
+}
+
+func TestAnalyzerSeverity(t *testing.T) {
+	tests := map[string]severity{
+		"attgo_no_pkg_logger":      severityError,
+		"attgo_capital_comment":    severityWarning,
+		"attgo_struct_field_order": severityInfo,
+		"attgo_tag_order":          severityInfo,
+		"attgo_non_nil_slice":      severityInfo,
+	}
+
+	for name, expected := range tests {
+		if s := analyzerSeverity(name); s != expected {
+			t.Errorf("%s: got severity %s, want %s", name, s, expected)
+		}
+	}
+}
+
+func TestAnalyzerSeverityMatchesPriority(t *testing.T) {
+	docs, err := attgolinter.RuleDocs()
+	if err != nil {
+		t.Fatalf("failed to build rule docs: %v", err)
+	}
+
+	priorities := map[string]severity{
+		attgolinter.PriorityHigh:   severityError,
+		attgolinter.PriorityMedium: severityWarning,
+		attgolinter.PriorityLow:    severityInfo,
+	}
+
+	for _, doc := range docs {
+		if s := analyzerSeverity(doc.Name); s != priorities[doc.Priority] {
+			t.Errorf("%s: got severity %s for %s priority, want %s", doc.Name, s, doc.Priority, priorities[doc.Priority])
+		}
+	}
+}
+
+func TestWriteBaseline(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "baseline.json")
+	source := filepath.Join(dir, "pkg", "service.go")
+
+	findings := []finding{
+		{Analyzer: "attgo_raw_string", Posn: token.Position{Filename: source, Line: 10}, Message: "first"},
+		{Analyzer: "attgo_raw_string", Posn: token.Position{Filename: source, Line: 20}, Message: "first"},
+		{Analyzer: "attgo_err_string", Posn: token.Position{Filename: source, Line: 30}, Message: "second"},
+	}
+
+	if err := baseline.Write(filename, baselineEntries(findings)); err != nil {
+		t.Fatalf("failed to write baseline: %v", err)
+	}
+
+	b, err := baseline.New(filename)
+	if err != nil {
+		t.Fatalf("failed to load baseline: %v", err)
+	}
+
+	if !b.Suppress("attgo_err_string", token.Position{Filename: source, Line: 35}, "second") {
+		t.Errorf("expected recorded finding to be suppressed")
+	}
+
+	if b.Suppress("attgo_raw_string", token.Position{Filename: source, Line: 30}, "second") {
+		t.Errorf("expected finding from a different analyzer not to be suppressed")
+	}
+
+	if b.Suppress("attgo_raw_string", token.Position{Filename: filepath.Join(dir, "pkg", "other.go"), Line: 10}, "first") {
+		t.Errorf("expected finding in a different file not to be suppressed")
+	}
+
+	// The two identical findings are recorded with a count of two.
+	for _, line := range []int{12, 22} {
+		if !b.Suppress("attgo_raw_string", token.Position{Filename: source, Line: line}, "first") {
+			t.Errorf("line %d: expected recorded finding to be suppressed", line)
+		}
+	}
+
+	if b.Suppress("attgo_raw_string", token.Position{Filename: source, Line: 32}, "first") {
+		t.Errorf("expected a third identical finding not to be suppressed")
+	}
+
+	// A finding already suppressed is suppressed again when reanalyzed.
+	if !b.Suppress("attgo_raw_string", token.Position{Filename: source, Line: 12}, "first") {
+		t.Errorf("expected reanalyzed finding to be suppressed")
+	}
+}
+
+func TestAnalyzeConcurrently(t *testing.T) {
+	patterns := []string{
+		"./testdata/src/concurrency/alpha",
+		"./testdata/src/concurrency/beta",
+		"./testdata/src/concurrency/gamma",
+	}
+
+	plugin, err := loadPlugin("", false)
+	if err != nil {
+		t.Fatalf("failed to load plugin: %v", err)
+	}
+
+	expected, err := analyzePackages(plugin, []string{"attgo_err_string"}, patterns, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(expected) != 6 {
+		t.Fatalf("got %d findings, want 6", len(expected))
+	}
+
+	for _, concurrency := range []int{2, 3, 8} {
+		findings, err := analyzePackages(plugin, []string{"attgo_err_string"}, patterns, concurrency)
+		if err != nil {
+			t.Fatalf("concurrency %d: unexpected error: %v", concurrency, err)
+		}
+
+		if !slices.Equal(findings, expected) {
+			t.Errorf("concurrency %d: got findings %v, want %v", concurrency, findings, expected)
+		}
+	}
+}
+
+func TestConcurrencyInvalid(t *testing.T) {
+	if code := run([]string{"-concurrency", "0"}, io.Discard, io.Discard); code != exitFailure {
+		t.Errorf("got exit code %d, want %d", code, exitFailure)
+	}
+}
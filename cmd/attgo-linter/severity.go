@@ -0,0 +1,87 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/token"
+)
+
+// severity is the severity of a finding.
+type severity int
+
+const (
+	severityInfo severity = iota
+	severityWarning
+	severityError
+)
+
+func (s severity) String() string {
+	switch s {
+	case severityError:
+		return "error"
+	case severityWarning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+// finding is a single diagnostic reported by an analyzer.
+type finding struct {
+	Analyzer string
+	Posn     token.Position
+	Message  string
+	Severity severity
+}
+
+// analyzerSeverities maps analyzers to the severity of their findings.
+// HIGH priority rules report errors and LOW priority rules report info;
+// everything else, including MEDIUM priority rules, reports warnings.
+var analyzerSeverities = map[string]severity{
+	// HIGH PRIORITY
+	"attgo_no_pkg_logger": severityError,
+	"attgo_enum_iota":     severityError,
+	"attgo_current_year":  severityError,
+
+	// LOW PRIORITY
+	"attgo_struct_field_order": severityInfo,
+	"attgo_interface_check":    severityInfo,
+	"attgo_close_once":         severityInfo,
+	"attgo_tag_order":          severityInfo,
+	"attgo_non_nil_slice":      severityInfo,
+}
+
+// analyzerSeverity returns the severity of findings from the named analyzer.
+func analyzerSeverity(name string) severity {
+	if s, ok := analyzerSeverities[name]; ok {
+		return s
+	}
+
+	return severityWarning
+}
+
+// parseSeverityThreshold parses the value of the -fail-on flag.
+func parseSeverityThreshold(value string) (severity, error) {
+	switch value {
+	case "error":
+		return severityError, nil
+	case "warning":
+		return severityWarning, nil
+	case "any":
+		return severityInfo, nil
+	default:
+		return severityInfo, fmt.Errorf("invalid -fail-on value %q; must be one of error, warning or any", value)
+	}
+}
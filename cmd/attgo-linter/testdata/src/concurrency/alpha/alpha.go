@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package alpha
+
+import "errors"
+
+var (
+	errFirst  = errors.New("First failure")
+	errSecond = errors.New("second failure.")
+)
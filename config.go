@@ -13,6 +13,16 @@
 
 package attgolinter
 
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/attestantio/attgo-linter/analyzers/capitalcomment"
+	"github.com/attestantio/attgo-linter/analyzers/mapinit"
+)
+
 // Config holds the configuration for the attgo linter plugin.
 type Config struct {
 	// HIGH PRIORITY - enabled by default
@@ -21,21 +31,295 @@ type Config struct {
 	EnableCurrentYear bool `json:"enable_current_year"`
 
 	// MEDIUM PRIORITY - disabled by default
-	EnableCapitalComment bool `json:"enable_capital_comment"`
-	EnableFuncOpts       bool `json:"enable_func_opts"`
-	EnableRawString      bool `json:"enable_raw_string"`
+	EnableCapitalComment      bool `json:"enable_capital_comment"`
+	EnableFuncOpts            bool `json:"enable_func_opts"`
+	EnableRawString           bool `json:"enable_raw_string"`
+	EnableStaticErr           bool `json:"enable_static_err"`
+	EnableUnkeyedFields       bool `json:"enable_unkeyed_fields"`
+	EnableNoContextBackground bool `json:"enable_no_context_background"`
+	EnableMapInit             bool `json:"enable_map_init"`
+	EnableSafeRoutine         bool `json:"enable_safe_routine"`
+	EnableAcronymCase         bool `json:"enable_acronym_case"`
+	EnableSelectCtx           bool `json:"enable_select_ctx"`
+	EnableErrString           bool `json:"enable_err_string"`
+	EnableRequireCtor         bool `json:"enable_require_ctor"`
+	EnableNoEmptyInterface    bool `json:"enable_no_empty_interface"`
+	EnableMetricsType         bool `json:"enable_metrics_type"`
+	EnableTestPkg             bool `json:"enable_test_pkg"`
+	EnableWrapExternal        bool `json:"enable_wrap_external"`
+	EnableJSONTagRequired     bool `json:"enable_json_tag_required"`
+	EnableHandlerSig          bool `json:"enable_handler_sig"`
+	EnableCtxValue            bool `json:"enable_ctx_value"`
+	EnableRetryBackoff        bool `json:"enable_retry_backoff"`
+	EnableDeadExport          bool `json:"enable_dead_export"`
+	EnableLifecycleMethod     bool `json:"enable_lifecycle_method"`
+	EnableVarConst            bool `json:"enable_var_const"`
+	EnableMetricName          bool `json:"enable_metric_name"`
+	EnableEnvRead             bool `json:"enable_env_read"`
+	EnableIOError             bool `json:"enable_io_error"`
+	EnableSharedMutable       bool `json:"enable_shared_mutable"`
+	EnableWgAdd               bool `json:"enable_wg_add"`
+	EnableInterfacePlacement  bool `json:"enable_interface_placement"`
+	EnableTimeEqual           bool `json:"enable_time_equal"`
+	EnableParamCount          bool `json:"enable_param_count"`
+	EnableDomainType          bool `json:"enable_domain_type"`
+	EnableIgnoredError        bool `json:"enable_ignored_error"`
+	EnableDuplicateLogger     bool `json:"enable_duplicate_logger"`
+	EnableReadonlyReturn      bool `json:"enable_readonly_return"`
+	EnableAssertLib           bool `json:"enable_assert_lib"`
+	EnableFloatStructCmp      bool `json:"enable_float_struct_cmp"`
+	EnableNilGuard            bool `json:"enable_nil_guard"`
+	EnableCtxLifetime         bool `json:"enable_ctx_lifetime"`
+	EnableDeferInLoop         bool `json:"enable_defer_in_loop"`
+	EnableNoLockCopy          bool `json:"enable_no_lock_copy"`
+	EnableLostCancel          bool `json:"enable_lost_cancel"`
+	EnableSlicePointer        bool `json:"enable_slice_pointer"`
 
 	// LOW PRIORITY - disabled by default
 	EnableStructFieldOrder bool `json:"enable_struct_field_order"`
 	EnableInterfaceCheck   bool `json:"enable_interface_check"`
+	EnableCloseOnce        bool `json:"enable_close_once"`
+	EnableTagOrder         bool `json:"enable_tag_order"`
+	EnableNonNilSlice      bool `json:"enable_non_nil_slice"`
+
+	// LocalModulePrefix is the module path prefix shared by the
+	// organisation's own modules, used by analyzers that treat code from
+	// sibling modules differently to third-party code.
+	// Consumed by: attgo_interface_check.
+	// Default: "github.com/attestantio"
+	LocalModulePrefix string `json:"local_module_prefix"`
+
+	// AnalyzerOrder lists analyzer names, such as "attgo_raw_string", to run
+	// first and in the order given. Other enabled analyzers follow in their
+	// default order.
+	AnalyzerOrder []string `json:"analyzer_order"`
+
+	// BaselineFile is the path of a JSON file listing existing findings to
+	// suppress, so that only new findings are reported.
+	// Default: ""
+	BaselineFile string `json:"baseline_file"`
+
+	// ProfileAnalyzers records the wall time each analyzer spends on each
+	// package. The attgo-linter command writes the totals, slowest first,
+	// when it finishes. Under golangci-lint, the totals are only written if
+	// ProfileFile is set.
+	ProfileAnalyzers bool `json:"profile_analyzers"`
+
+	// ProfileFile is the path of a file to write analyzer timings to when
+	// ProfileAnalyzers is set. It is rewritten in the background at most
+	// once a second while analyzers run, and the attgo-linter command writes
+	// the final totals when it finishes.
+	// Default: "" (standard error, from the attgo-linter command only)
+	ProfileFile string `json:"profile_file"`
+
+	// PathRules maps directory prefixes, relative to the working directory,
+	// to analyzer names and whether each is enabled for files in that tree.
+	// The most specific prefix mentioning an analyzer wins.
+	// Default: none
+	PathRules map[string]map[string]bool `json:"path_rules"`
 
 	// LoggerTypePatterns specifies the type patterns to detect as loggers.
 	// Default patterns include common logging libraries.
+	// Setting this replaces the default list.
 	LoggerTypePatterns []string `json:"logger_type_patterns"`
 
+	// LoggerTypePatternsAppend specifies additional logger type patterns.
+	// These are appended to LoggerTypePatterns rather than replacing them.
+	LoggerTypePatternsAppend []string `json:"logger_type_patterns_append"`
+
+	// NoPkgLoggerNopConstructors specifies the no-op logger constructors
+	// whose results may be held in package-level variables as sentinels.
+	// Default: ["zerolog.Nop", "zap.NewNop"]
+	// Setting this replaces the default list.
+	NoPkgLoggerNopConstructors []string `json:"no_pkg_logger_nop_constructors"`
+
 	// EnumTypeSuffixes specifies the suffixes that identify enum types.
 	// Default: ["Type", "Status", "State", "Kind", "Mode"]
+	// Setting this replaces the default list.
 	EnumTypeSuffixes []string `json:"enum_type_suffixes"`
+
+	// EnumTypeSuffixesAppend specifies additional enum type suffixes.
+	// These are appended to EnumTypeSuffixes rather than replacing them.
+	EnumTypeSuffixesAppend []string `json:"enum_type_suffixes_append"`
+
+	// EnumIotaExcludeTypes lists exact type names that are not enum types
+	// even though they end with an enum type suffix, such as ContentType.
+	EnumIotaExcludeTypes []string `json:"enum_iota_exclude_types"`
+
+	// EnumIotaSafeString requires String() methods on enum types to guard
+	// receiver-indexed array and slice lookups with a bounds check.
+	EnumIotaSafeString bool `json:"enum_iota_safe_string"`
+
+	// EnumIotaSingleBlock requires all constants of an enum type to be
+	// declared in a single const block.
+	EnumIotaSingleBlock bool `json:"enum_iota_single_block"`
+
+	// EnumIotaRequireUnknown requires integer enums starting at iota to name
+	// their zero value <Type>Unknown.
+	EnumIotaRequireUnknown bool `json:"enum_iota_require_unknown"`
+
+	// EnumIotaUnsignedBitmask requires bitmask types, named with a Flags,
+	// Mask or Bits suffix, to have an unsigned integer underlying type.
+	EnumIotaUnsignedBitmask bool `json:"enum_iota_unsigned_bitmask"`
+
+	// UnkeyedFieldsThreshold is the maximum number of positional fields
+	// allowed in a struct literal before keyed fields are required.
+	// Default: 3
+	UnkeyedFieldsThreshold int `json:"unkeyed_fields_threshold"`
+
+	// UnkeyedFieldsIgnorePackages lists package paths whose struct types are
+	// not checked for unkeyed literals. Subpackages are also ignored.
+	// Standard library types are always ignored.
+	UnkeyedFieldsIgnorePackages []string `json:"unkeyed_fields_ignore_packages"`
+
+	// CapitalCommentMode selects the comments that must start with a capital
+	// letter: "first-line", "all-lines" or "doc-only".
+	// Default: "first-line"
+	CapitalCommentMode string `json:"capital_comment_mode"`
+
+	// CapitalCommentRequirePeriod requires doc comments on exported
+	// declarations to end with a period, exclamation mark or question mark.
+	CapitalCommentRequirePeriod bool `json:"capital_comment_require_period"`
+
+	// CapitalCommentCheckScope skips comments whose first word names a field,
+	// parameter or variable declared in the enclosing declaration.
+	CapitalCommentCheckScope bool `json:"capital_comment_check_scope"`
+
+	// CapitalCommentLowercaseGlossary lists terms, such as "ctx" or "zerolog",
+	// that may start a comment in lowercase. Terms are case-sensitive.
+	CapitalCommentLowercaseGlossary []string `json:"capital_comment_lowercase_glossary"`
+
+	// CapitalCommentNoAllCaps reports comments whose first word is written
+	// entirely in capitals, unless it is one of AcronymCaseInitialisms.
+	CapitalCommentNoAllCaps bool `json:"capital_comment_no_all_caps"`
+
+	// CapitalCommentSkipPathsAndFlags skips comments that consist of a file
+	// path, such as config/app.yaml, or start with a command-line flag.
+	CapitalCommentSkipPathsAndFlags bool `json:"capital_comment_skip_paths_and_flags"`
+
+	// FuncOptsAllowBuilders exempts constructors that return a builder, a type
+	// whose name ends in Builder or that has With... methods.
+	// Default: true
+	FuncOptsAllowBuilders bool `json:"func_opts_allow_builders"`
+
+	// RawStringSkipFilePatterns specifies glob patterns matched against file
+	// base names; matching files are not checked by the raw-string rule.
+	// Default: ["*.pb.go"]
+	// Setting this replaces the default list.
+	RawStringSkipFilePatterns []string `json:"raw_string_skip_file_patterns"`
+
+	// RawStringSummaryOnly reports one summary per file counting the strings
+	// that could be raw strings, instead of one diagnostic per string.
+	RawStringSummaryOnly bool `json:"raw_string_summary_only"`
+
+	// RawStringReportUnneeded also reports raw strings that contain no
+	// quotes, backslashes or newlines and could be double-quoted.
+	RawStringReportUnneeded bool `json:"raw_string_report_unneeded"`
+
+	// RawStringMinEscapeRatio is the minimum ratio of escape sequences to the
+	// length of a string's value, such as 0.05, for it to be reported. It
+	// applies in addition to the minimum of three escape sequences.
+	// Default: 0 (off)
+	RawStringMinEscapeRatio float64 `json:"raw_string_min_escape_ratio"`
+
+	// InterfaceCheckTargetFile is the base name of a file, such as
+	// "compliance_checks.go", to which suggested fixes append compliance
+	// checks. The file must already exist in the package.
+	// Default: "" (checks are placed after the struct declaration)
+	InterfaceCheckTargetFile string `json:"interface_check_target_file"`
+
+	// NoContextBackgroundAllowMainInit allows context.Background() and
+	// context.TODO() inside func main and func init.
+	// Default: true
+	NoContextBackgroundAllowMainInit bool `json:"no_context_background_allow_main_init"`
+
+	// MapInitMode controls how constructors must initialise map and slice
+	// fields: "require-explicit" or "forbid-eager".
+	// Default: "require-explicit"
+	MapInitMode string `json:"map_init_mode"`
+
+	// SafeRoutineLauncher is a safe-launch helper, such as "pool.Go", whose
+	// use inside a goroutine satisfies the safe-routine rule.
+	// Default: "" (none)
+	SafeRoutineLauncher string `json:"safe_routine_launcher"`
+
+	// AcronymCaseInitialisms specifies the initialisms that must be
+	// uniformly cased in exported identifiers.
+	// Default: ["API", "DNS", "HTML", "HTTP", "HTTPS", "ID", "IP", "JSON", "RPC", "SQL", "SSH", "TCP", "TLS", "TTL", "UDP", "URI", "URL", "UUID", "XML"]
+	// Setting this replaces the default list.
+	AcronymCaseInitialisms []string `json:"acronym_case_initialisms"`
+
+	// StructFieldOrderConsolidate reports each misordered struct once with
+	// the suggested order of all its fields, instead of once per field.
+	StructFieldOrderConsolidate bool `json:"struct_field_order_consolidate"`
+
+	// StructFieldOrderGroupTag is a struct tag key, such as "group", whose
+	// values group fields. Within a category, fields with the same value
+	// must be declared together.
+	// Default: "" (no grouping)
+	StructFieldOrderGroupTag string `json:"struct_field_order_group_tag"`
+
+	// StructFieldOrderDepsAsInterfaces reports dependency fields whose type
+	// is a pointer to a concrete struct rather than an interface.
+	StructFieldOrderDepsAsInterfaces bool `json:"struct_field_order_deps_as_interfaces"`
+
+	// StructFieldOrderExportedFirst reports exported fields declared after
+	// an unexported field of the same category.
+	StructFieldOrderExportedFirst bool `json:"struct_field_order_exported_first"`
+
+	// StructFieldOrderOptimizePadding reports structs whose size would
+	// shrink if their data fields were ordered by descending alignment.
+	StructFieldOrderOptimizePadding bool `json:"struct_field_order_optimize_padding"`
+
+	// RequireCtorServicesOnly limits the require-ctor rule to service-like
+	// types, such as those ending in Service, Client or Provider.
+	RequireCtorServicesOnly bool `json:"require_ctor_services_only"`
+
+	// MetricsTypePatterns specifies the approved types for metrics fields.
+	// Default: ["*prometheus.Registry", "prometheus.Registerer", "metrics.Service"]
+	// Setting this replaces the default list.
+	MetricsTypePatterns []string `json:"metrics_type_patterns"`
+
+	// RetryBackoffHelper names the team's backoff helper, such as
+	// "backoff.Retry", in retry-backoff diagnostics. Loops within the
+	// helper itself are not reported.
+	// Default: ""
+	RetryBackoffHelper string `json:"retry_backoff_helper"`
+
+	// EnvReadAllowedPackages lists package paths whose code, including that
+	// of their subpackages, may read environment variables.
+	// Package main and test files are always allowed.
+	EnvReadAllowedPackages []string `json:"env_read_allowed_packages"`
+
+	// IOErrorFunctions lists the IO functions whose discarded errors are
+	// reported, as patterns such as "os.ReadFile" or "net/http.Get".
+	// Default: ["os.ReadFile", "os.WriteFile", "os.Open", "os.OpenFile",
+	// "os.Create", "os.Remove", "os.MkdirAll", "io.ReadAll", "io.Copy",
+	// "http.Get", "http.Post", "net.Dial"]
+	// Setting this replaces the default list.
+	IOErrorFunctions []string `json:"io_error_functions"`
+
+	// ParamCountMax is the maximum number of parameters, not counting a
+	// context.Context, a function or method may take.
+	// Default: 5
+	ParamCountMax int `json:"param_count_max"`
+
+	// DomainTypeSuffixes lists the parameter name suffixes, such as "ID" in
+	// userID, that identify parameters which should use a domain type.
+	// Default: ["ID"]
+	// Setting this replaces the default list.
+	DomainTypeSuffixes []string `json:"domain_type_suffixes"`
+
+	// AssertLibName is the package name of the assertion library, such as
+	// "require" or "assert", suggested in assert-lib diagnostics.
+	// Default: "require"
+	AssertLibName string `json:"assert_lib_name"`
+
+	// TagOrder lists struct tag keys, such as "json" and "yaml", in the
+	// order they must appear. Keys not listed may appear anywhere.
+	// Default: ["json", "yaml", "validate"]
+	// Setting this replaces the default list.
+	TagOrder []string `json:"tag_order"`
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -48,13 +332,59 @@ func DefaultConfig() *Config {
 		EnableCurrentYear: true,
 
 		// MEDIUM PRIORITY - disabled by default
-		EnableCapitalComment: false,
-		EnableFuncOpts:       false,
-		EnableRawString:      false,
+		EnableCapitalComment:      false,
+		EnableFuncOpts:            false,
+		EnableRawString:           false,
+		EnableStaticErr:           false,
+		EnableUnkeyedFields:       false,
+		EnableNoContextBackground: false,
+		EnableMapInit:             false,
+		EnableSafeRoutine:         false,
+		EnableAcronymCase:         false,
+		EnableSelectCtx:           false,
+		EnableErrString:           false,
+		EnableRequireCtor:         false,
+		EnableNoEmptyInterface:    false,
+		EnableMetricsType:         false,
+		EnableTestPkg:             false,
+		EnableWrapExternal:        false,
+		EnableJSONTagRequired:     false,
+		EnableHandlerSig:          false,
+		EnableCtxValue:            false,
+		EnableRetryBackoff:        false,
+		EnableDeadExport:          false,
+		EnableLifecycleMethod:     false,
+		EnableVarConst:            false,
+		EnableMetricName:          false,
+		EnableEnvRead:             false,
+		EnableIOError:             false,
+		EnableSharedMutable:       false,
+		EnableWgAdd:               false,
+		EnableInterfacePlacement:  false,
+		EnableTimeEqual:           false,
+		EnableParamCount:          false,
+		EnableDomainType:          false,
+		EnableIgnoredError:        false,
+		EnableDuplicateLogger:     false,
+		EnableReadonlyReturn:      false,
+		EnableAssertLib:           false,
+		EnableFloatStructCmp:      false,
+		EnableNilGuard:            false,
+		EnableCtxLifetime:         false,
+		EnableDeferInLoop:         false,
+		EnableNoLockCopy:          false,
+		EnableLostCancel:          false,
+		EnableSlicePointer:        false,
 
 		// LOW PRIORITY - disabled by default
 		EnableStructFieldOrder: false,
 		EnableInterfaceCheck:   false,
+		EnableCloseOnce:        false,
+		EnableTagOrder:         false,
+		EnableNonNilSlice:      false,
+
+		// Default local module prefix
+		LocalModulePrefix: "github.com/attestantio",
 
 		// Default logger patterns
 		LoggerTypePatterns: []string{
@@ -74,6 +404,12 @@ func DefaultConfig() *Config {
 			"*log.Logger",
 		},
 
+		// Default no-op logger constructors
+		NoPkgLoggerNopConstructors: []string{
+			"zerolog.Nop",
+			"zap.NewNop",
+		},
+
 		// Default enum suffixes
 		EnumTypeSuffixes: []string{
 			"Type",
@@ -82,10 +418,84 @@ func DefaultConfig() *Config {
 			"Kind",
 			"Mode",
 		},
+
+		// Allow builder-returning constructors by default
+		FuncOptsAllowBuilders: true,
+
+		// Skip protobuf descriptors by default
+		RawStringSkipFilePatterns: []string{
+			"*.pb.go",
+		},
+
+		// Default unkeyed fields threshold
+		UnkeyedFieldsThreshold: 3,
+
+		// Allow fabricated contexts in main and init by default
+		NoContextBackgroundAllowMainInit: true,
+
+		// Check the first line of each comment group by default
+		CapitalCommentMode: "first-line",
+
+		// Require constructors to initialise map and slice fields by default
+		MapInitMode: "require-explicit",
+
+		// Default initialisms for acronym casing
+		AcronymCaseInitialisms: []string{
+			"API",
+			"DNS",
+			"HTML",
+			"HTTP",
+			"HTTPS",
+			"ID",
+			"IP",
+			"JSON",
+			"RPC",
+			"SQL",
+			"SSH",
+			"TCP",
+			"TLS",
+			"TTL",
+			"UDP",
+			"URI",
+			"URL",
+			"UUID",
+			"XML",
+		},
+
+		// Default approved metrics types
+		MetricsTypePatterns: []string{
+			"*prometheus.Registry",
+			"prometheus.Registerer",
+			"metrics.Service",
+		},
+		IOErrorFunctions: []string{
+			"os.ReadFile",
+			"os.WriteFile",
+			"os.Open",
+			"os.OpenFile",
+			"os.Create",
+			"os.Remove",
+			"os.MkdirAll",
+			"io.ReadAll",
+			"io.Copy",
+			"http.Get",
+			"http.Post",
+			"net.Dial",
+		},
+		ParamCountMax:      5,
+		DomainTypeSuffixes: []string{"ID"},
+		AssertLibName:      "require",
+		TagOrder:           []string{"json", "yaml", "validate"},
 	}
 }
 
 // Merge applies non-zero values from other to c.
+//
+// List settings are applied in two steps: a non-empty replace field
+// (e.g. LoggerTypePatterns) first replaces the current list, then the
+// matching append field (e.g. LoggerTypePatternsAppend) is appended to
+// the result. Setting both therefore yields the replacement list plus
+// the appended entries.
 func (c *Config) Merge(other *Config) {
 	if other == nil {
 		return
@@ -96,11 +506,251 @@ func (c *Config) Merge(other *Config) {
 	// so we check if the value differs from what would be "unset".
 	// This is handled by the plugin initialization.
 
+	if other.LocalModulePrefix != "" {
+		c.LocalModulePrefix = other.LocalModulePrefix
+	}
+
+	if len(other.AnalyzerOrder) > 0 {
+		c.AnalyzerOrder = other.AnalyzerOrder
+	}
+
+	if other.BaselineFile != "" {
+		c.BaselineFile = other.BaselineFile
+	}
+
+	if other.ProfileFile != "" {
+		c.ProfileFile = other.ProfileFile
+	}
+
+	if len(other.PathRules) > 0 {
+		c.PathRules = other.PathRules
+	}
+
 	if len(other.LoggerTypePatterns) > 0 {
 		c.LoggerTypePatterns = other.LoggerTypePatterns
 	}
 
+	if len(other.LoggerTypePatternsAppend) > 0 {
+		c.LoggerTypePatterns = appendUnique(c.LoggerTypePatterns, other.LoggerTypePatternsAppend)
+	}
+
+	if len(other.NoPkgLoggerNopConstructors) > 0 {
+		c.NoPkgLoggerNopConstructors = other.NoPkgLoggerNopConstructors
+	}
+
 	if len(other.EnumTypeSuffixes) > 0 {
 		c.EnumTypeSuffixes = other.EnumTypeSuffixes
 	}
+
+	if len(other.EnumTypeSuffixesAppend) > 0 {
+		c.EnumTypeSuffixes = appendUnique(c.EnumTypeSuffixes, other.EnumTypeSuffixesAppend)
+	}
+
+	if len(other.EnumIotaExcludeTypes) > 0 {
+		c.EnumIotaExcludeTypes = other.EnumIotaExcludeTypes
+	}
+
+	if len(other.RawStringSkipFilePatterns) > 0 {
+		c.RawStringSkipFilePatterns = other.RawStringSkipFilePatterns
+	}
+
+	if other.RawStringMinEscapeRatio != 0 {
+		c.RawStringMinEscapeRatio = other.RawStringMinEscapeRatio
+	}
+
+	if other.UnkeyedFieldsThreshold > 0 {
+		c.UnkeyedFieldsThreshold = other.UnkeyedFieldsThreshold
+	}
+
+	if len(other.UnkeyedFieldsIgnorePackages) > 0 {
+		c.UnkeyedFieldsIgnorePackages = other.UnkeyedFieldsIgnorePackages
+	}
+
+	if other.CapitalCommentMode != "" {
+		c.CapitalCommentMode = other.CapitalCommentMode
+	}
+
+	if len(other.CapitalCommentLowercaseGlossary) > 0 {
+		c.CapitalCommentLowercaseGlossary = other.CapitalCommentLowercaseGlossary
+	}
+	if other.InterfaceCheckTargetFile != "" {
+		c.InterfaceCheckTargetFile = other.InterfaceCheckTargetFile
+	}
+	if other.StructFieldOrderGroupTag != "" {
+		c.StructFieldOrderGroupTag = other.StructFieldOrderGroupTag
+	}
+	if other.MapInitMode != "" {
+		c.MapInitMode = other.MapInitMode
+	}
+	if other.SafeRoutineLauncher != "" {
+		c.SafeRoutineLauncher = other.SafeRoutineLauncher
+	}
+	if len(other.AcronymCaseInitialisms) > 0 {
+		c.AcronymCaseInitialisms = other.AcronymCaseInitialisms
+	}
+	if len(other.MetricsTypePatterns) > 0 {
+		c.MetricsTypePatterns = other.MetricsTypePatterns
+	}
+	if other.RetryBackoffHelper != "" {
+		c.RetryBackoffHelper = other.RetryBackoffHelper
+	}
+	if len(other.EnvReadAllowedPackages) > 0 {
+		c.EnvReadAllowedPackages = other.EnvReadAllowedPackages
+	}
+	if len(other.IOErrorFunctions) > 0 {
+		c.IOErrorFunctions = other.IOErrorFunctions
+	}
+	if other.ParamCountMax > 0 {
+		c.ParamCountMax = other.ParamCountMax
+	}
+	if len(other.DomainTypeSuffixes) > 0 {
+		c.DomainTypeSuffixes = other.DomainTypeSuffixes
+	}
+
+	if other.AssertLibName != "" {
+		c.AssertLibName = other.AssertLibName
+	}
+	if len(other.TagOrder) > 0 {
+		c.TagOrder = other.TagOrder
+	}
+}
+
+// Validate checks the configuration for contradictory settings, such as an
+// enabled analyzer whose configuration leaves it nothing to check.
+// All problems found are returned together.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.EnableNoPkgLogger && len(c.LoggerTypePatterns) == 0 {
+		errs = append(errs, errors.New("enable_no_pkg_logger is true but logger_type_patterns is empty"))
+	}
+
+	if c.EnableEnumIota && len(c.EnumTypeSuffixes) == 0 {
+		errs = append(errs, errors.New("enable_enum_iota is true but enum_type_suffixes is empty"))
+	}
+
+	if c.EnableTagOrder && len(c.TagOrder) == 0 {
+		errs = append(errs, errors.New("enable_tag_order is true but tag_order is empty"))
+	}
+
+	if c.EnableAcronymCase && len(c.AcronymCaseInitialisms) == 0 {
+		errs = append(errs, errors.New("enable_acronym_case is true but acronym_case_initialisms is empty"))
+	}
+
+	if c.EnableMetricsType && len(c.MetricsTypePatterns) == 0 {
+		errs = append(errs, errors.New("enable_metrics_type is true but metrics_type_patterns is empty"))
+	}
+
+	if c.EnableIOError && len(c.IOErrorFunctions) == 0 {
+		errs = append(errs, errors.New("enable_io_error is true but io_error_functions is empty"))
+	}
+
+	if c.EnableDomainType && len(c.DomainTypeSuffixes) == 0 {
+		errs = append(errs, errors.New("enable_domain_type is true but domain_type_suffixes is empty"))
+	}
+
+	if c.EnableCapitalComment {
+		if _, err := capitalcomment.ParseMode(c.CapitalCommentMode); err != nil {
+			errs = append(errs, fmt.Errorf("enable_capital_comment is true but capital_comment_mode is invalid: %w", err))
+		}
+	}
+
+	if c.EnableMapInit {
+		if _, err := mapinit.ParseMode(c.MapInitMode); err != nil {
+			errs = append(errs, fmt.Errorf("enable_map_init is true but map_init_mode is invalid: %w", err))
+		}
+	}
+
+	for _, pattern := range c.RawStringSkipFilePatterns {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Errorf("raw_string_skip_file_patterns entry %q is not a valid pattern", pattern))
+		}
+	}
+
+	if c.RawStringMinEscapeRatio < 0 {
+		errs = append(errs, fmt.Errorf("raw_string_min_escape_ratio %v must not be negative", c.RawStringMinEscapeRatio))
+	}
+
+	if name := c.InterfaceCheckTargetFile; name != "" && (strings.ContainsAny(name, `/\`) || !strings.HasSuffix(name, ".go")) {
+		errs = append(errs, fmt.Errorf("interface_check_target_file %q must be the base name of a .go file", name))
+	}
+
+	return errors.Join(errs...)
+}
+
+// enableFlags returns the analyzer enable flags keyed by their settings name.
+func (c *Config) enableFlags() map[string]*bool {
+	return map[string]*bool{
+		"enable_no_pkg_logger":         &c.EnableNoPkgLogger,
+		"enable_enum_iota":             &c.EnableEnumIota,
+		"enable_current_year":          &c.EnableCurrentYear,
+		"enable_capital_comment":       &c.EnableCapitalComment,
+		"enable_func_opts":             &c.EnableFuncOpts,
+		"enable_raw_string":            &c.EnableRawString,
+		"enable_static_err":            &c.EnableStaticErr,
+		"enable_unkeyed_fields":        &c.EnableUnkeyedFields,
+		"enable_no_context_background": &c.EnableNoContextBackground,
+		"enable_map_init":              &c.EnableMapInit,
+		"enable_safe_routine":          &c.EnableSafeRoutine,
+		"enable_acronym_case":          &c.EnableAcronymCase,
+		"enable_select_ctx":            &c.EnableSelectCtx,
+		"enable_err_string":            &c.EnableErrString,
+		"enable_require_ctor":          &c.EnableRequireCtor,
+		"enable_no_empty_interface":    &c.EnableNoEmptyInterface,
+		"enable_metrics_type":          &c.EnableMetricsType,
+		"enable_test_pkg":              &c.EnableTestPkg,
+		"enable_wrap_external":         &c.EnableWrapExternal,
+		"enable_json_tag_required":     &c.EnableJSONTagRequired,
+		"enable_handler_sig":           &c.EnableHandlerSig,
+		"enable_ctx_value":             &c.EnableCtxValue,
+		"enable_retry_backoff":         &c.EnableRetryBackoff,
+		"enable_dead_export":           &c.EnableDeadExport,
+		"enable_lifecycle_method":      &c.EnableLifecycleMethod,
+		"enable_var_const":             &c.EnableVarConst,
+		"enable_metric_name":           &c.EnableMetricName,
+		"enable_env_read":              &c.EnableEnvRead,
+		"enable_io_error":              &c.EnableIOError,
+		"enable_shared_mutable":        &c.EnableSharedMutable,
+		"enable_wg_add":                &c.EnableWgAdd,
+		"enable_interface_placement":   &c.EnableInterfacePlacement,
+		"enable_time_equal":            &c.EnableTimeEqual,
+		"enable_param_count":           &c.EnableParamCount,
+		"enable_domain_type":           &c.EnableDomainType,
+		"enable_ignored_error":         &c.EnableIgnoredError,
+		"enable_duplicate_logger":      &c.EnableDuplicateLogger,
+		"enable_readonly_return":       &c.EnableReadonlyReturn,
+		"enable_assert_lib":            &c.EnableAssertLib,
+		"enable_float_struct_cmp":      &c.EnableFloatStructCmp,
+		"enable_nil_guard":             &c.EnableNilGuard,
+		"enable_ctx_lifetime":          &c.EnableCtxLifetime,
+		"enable_defer_in_loop":         &c.EnableDeferInLoop,
+		"enable_no_lock_copy":          &c.EnableNoLockCopy,
+		"enable_lost_cancel":           &c.EnableLostCancel,
+		"enable_slice_pointer":         &c.EnableSlicePointer,
+		"enable_struct_field_order":    &c.EnableStructFieldOrder,
+		"enable_interface_check":       &c.EnableInterfaceCheck,
+		"enable_close_once":            &c.EnableCloseOnce,
+		"enable_tag_order":             &c.EnableTagOrder,
+		"enable_non_nil_slice":         &c.EnableNonNilSlice,
+	}
+}
+
+// appendUnique returns a new slice containing base followed by the entries
+// of extra that are not already present.
+func appendUnique(base []string, extra []string) []string {
+	seen := make(map[string]bool, len(base)+len(extra))
+	res := make([]string, 0, len(base)+len(extra))
+
+	for _, list := range [][]string{base, extra} {
+		for _, entry := range list {
+			if seen[entry] {
+				continue
+			}
+
+			seen[entry] = true
+			res = append(res, entry)
+		}
+	}
+
+	return res
 }
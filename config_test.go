@@ -0,0 +1,218 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attgolinter_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	attgolinter "github.com/attestantio/attgo-linter"
+)
+
+func TestMergeListSettings(t *testing.T) {
+	defaults := attgolinter.DefaultConfig()
+
+	tests := []struct {
+		name           string
+		other          *attgolinter.Config
+		loggerPatterns []string
+		enumSuffixes   []string
+	}{
+		{
+			name:           "Nil",
+			other:          nil,
+			loggerPatterns: defaults.LoggerTypePatterns,
+			enumSuffixes:   defaults.EnumTypeSuffixes,
+		},
+		{
+			name:           "Empty",
+			other:          &attgolinter.Config{},
+			loggerPatterns: defaults.LoggerTypePatterns,
+			enumSuffixes:   defaults.EnumTypeSuffixes,
+		},
+		{
+			name: "Replace",
+			other: &attgolinter.Config{
+				LoggerTypePatterns: []string{"mylog.Logger"},
+				EnumTypeSuffixes:   []string{"Phase"},
+			},
+			loggerPatterns: []string{"mylog.Logger"},
+			enumSuffixes:   []string{"Phase"},
+		},
+		{
+			name: "Append",
+			other: &attgolinter.Config{
+				LoggerTypePatternsAppend: []string{"mylog.Logger"},
+				EnumTypeSuffixesAppend:   []string{"Phase"},
+			},
+			loggerPatterns: append(slices.Clone(defaults.LoggerTypePatterns), "mylog.Logger"),
+			enumSuffixes:   append(slices.Clone(defaults.EnumTypeSuffixes), "Phase"),
+		},
+		{
+			name: "AppendDuplicate",
+			other: &attgolinter.Config{
+				LoggerTypePatternsAppend: []string{"zerolog.Logger"},
+				EnumTypeSuffixesAppend:   []string{"Type"},
+			},
+			loggerPatterns: defaults.LoggerTypePatterns,
+			enumSuffixes:   defaults.EnumTypeSuffixes,
+		},
+		{
+			name: "ReplaceAndAppend",
+			other: &attgolinter.Config{
+				LoggerTypePatterns:       []string{"mylog.Logger"},
+				LoggerTypePatternsAppend: []string{"*mylog.Logger"},
+				EnumTypeSuffixes:         []string{"Phase"},
+				EnumTypeSuffixesAppend:   []string{"Stage"},
+			},
+			loggerPatterns: []string{"mylog.Logger", "*mylog.Logger"},
+			enumSuffixes:   []string{"Phase", "Stage"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := attgolinter.DefaultConfig()
+			cfg.Merge(test.other)
+
+			if !slices.Equal(cfg.LoggerTypePatterns, test.loggerPatterns) {
+				t.Errorf("logger patterns: got %v, want %v", cfg.LoggerTypePatterns, test.loggerPatterns)
+			}
+			if !slices.Equal(cfg.EnumTypeSuffixes, test.enumSuffixes) {
+				t.Errorf("enum suffixes: got %v, want %v", cfg.EnumTypeSuffixes, test.enumSuffixes)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *attgolinter.Config)
+		errs   []string
+	}{
+		{
+			name:   "Defaults",
+			modify: func(*attgolinter.Config) {},
+		},
+		{
+			name: "EmptyLoggerPatterns",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.LoggerTypePatterns = nil
+			},
+			errs: []string{"enable_no_pkg_logger is true but logger_type_patterns is empty"},
+		},
+		{
+			name: "EmptyLoggerPatternsDisabled",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.EnableNoPkgLogger = false
+				cfg.LoggerTypePatterns = nil
+			},
+		},
+		{
+			name: "InvalidCapitalCommentMode",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.EnableCapitalComment = true
+				cfg.CapitalCommentMode = "all"
+			},
+			errs: []string{"enable_capital_comment is true but capital_comment_mode is invalid"},
+		},
+		{
+			name: "NegativeRawStringMinEscapeRatio",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.RawStringMinEscapeRatio = -0.5
+			},
+			errs: []string{"raw_string_min_escape_ratio -0.5 must not be negative"},
+		},
+		{
+			name: "MergedNegativeRawStringMinEscapeRatio",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.Merge(&attgolinter.Config{RawStringMinEscapeRatio: -0.5})
+			},
+			errs: []string{"raw_string_min_escape_ratio -0.5 must not be negative"},
+		},
+		{
+			name: "InvalidMapInitMode",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.EnableMapInit = true
+				cfg.MapInitMode = "eager"
+			},
+			errs: []string{"enable_map_init is true but map_init_mode is invalid"},
+		},
+		{
+			name: "EmptyTagOrder",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.EnableTagOrder = true
+				cfg.TagOrder = nil
+			},
+			errs: []string{"enable_tag_order is true but tag_order is empty"},
+		},
+		{
+			name: "InvalidSkipPattern",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.RawStringSkipFilePatterns = []string{"["}
+			},
+			errs: []string{`raw_string_skip_file_patterns entry "[" is not a valid pattern`},
+		},
+		{
+			name: "TargetFileWithDirectory",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.InterfaceCheckTargetFile = "checks/compliance.go"
+			},
+			errs: []string{`interface_check_target_file "checks/compliance.go" must be the base name of a .go file`},
+		},
+		{
+			name: "Multiple",
+			modify: func(cfg *attgolinter.Config) {
+				cfg.EnumTypeSuffixes = nil
+				cfg.EnableMetricsType = true
+				cfg.MetricsTypePatterns = nil
+				cfg.EnableAcronymCase = true
+				cfg.AcronymCaseInitialisms = nil
+			},
+			errs: []string{
+				"enable_enum_iota is true but enum_type_suffixes is empty",
+				"enable_metrics_type is true but metrics_type_patterns is empty",
+				"enable_acronym_case is true but acronym_case_initialisms is empty",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := attgolinter.DefaultConfig()
+			test.modify(cfg)
+
+			err := cfg.Validate()
+			if len(test.errs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected errors %v", test.errs)
+			}
+
+			for _, want := range test.errs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
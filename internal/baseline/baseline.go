@@ -0,0 +1,228 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package baseline suppresses findings recorded in a baseline file, so that
+// only new findings are reported when adopting a rule on existing code.
+package baseline
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Entry is a finding recorded in a baseline. Entries do not record
+// positions, so they still match after unrelated edits move the code.
+// Count is the number of identical findings in the file; zero means one.
+type Entry struct {
+	Analyzer string `json:"analyzer"`
+	File     string `json:"file"`
+	Message  string `json:"message"`
+	Count    int    `json:"count,omitempty"`
+}
+
+// entryKey identifies identical findings.
+type entryKey struct {
+	analyzer string
+	file     string
+	message  string
+}
+
+// occurrences returns the number of findings an entry records.
+func (e Entry) occurrences() int {
+	return max(e.Count, 1)
+}
+
+// file is the JSON layout of a baseline file.
+type file struct {
+	Findings []Entry `json:"findings"`
+}
+
+// Baseline is a set of findings to suppress. Analyzers may run
+// concurrently, so it is safe for concurrent use.
+type Baseline struct {
+	dir    string
+	counts map[entryKey]int
+	// The positions of the findings suppressed so far for each entry.
+	suppressed map[entryKey]map[string]bool
+	mu         sync.Mutex
+}
+
+// New loads a baseline from a file. File names in the baseline are relative to
+// the directory containing it.
+func New(filename string) (*Baseline, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+
+	var contents file
+	if err := json.Unmarshal(data, &contents); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline %s: %w", filename, err)
+	}
+
+	dir, err := baseDir(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[entryKey]int, len(contents.Findings))
+	for _, entry := range contents.Findings {
+		counts[entryKey{entry.Analyzer, entry.File, entry.Message}] += entry.occurrences()
+	}
+
+	return &Baseline{
+		dir:        dir,
+		counts:     counts,
+		suppressed: make(map[entryKey]map[string]bool),
+	}, nil
+}
+
+// Write writes a baseline file recording the given findings, whose file
+// names are made relative to the directory containing the baseline.
+// Identical findings are recorded as one entry with their count, and
+// entries are sorted, so the file is stable.
+func Write(filename string, findings []Entry) error {
+	dir, err := baseDir(filename)
+	if err != nil {
+		return err
+	}
+
+	indices := make(map[entryKey]int, len(findings))
+	contents := file{
+		Findings: make([]Entry, 0, len(findings)),
+	}
+
+	for _, finding := range findings {
+		finding.File = relativePath(dir, finding.File)
+
+		k := entryKey{finding.Analyzer, finding.File, finding.Message}
+		if i, exists := indices[k]; exists {
+			contents.Findings[i].Count += finding.occurrences()
+
+			continue
+		}
+
+		finding.Count = finding.occurrences()
+		indices[k] = len(contents.Findings)
+		contents.Findings = append(contents.Findings, finding)
+	}
+
+	sort.Slice(contents.Findings, func(i, j int) bool {
+		a, b := contents.Findings[i], contents.Findings[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Analyzer != b.Analyzer {
+			return a.Analyzer < b.Analyzer
+		}
+
+		return a.Message < b.Message
+	})
+
+	data, err := json.MarshalIndent(contents, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode baseline: %w", err)
+	}
+
+	if err := os.WriteFile(filename, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write baseline: %w", err)
+	}
+
+	return nil
+}
+
+// Suppress checks if a finding from an analyzer at a position is recorded
+// in the baseline. An entry suppresses at most as many findings as it
+// counts, so a new finding identical to a recorded one is still reported.
+// A finding at a position already suppressed, as when a file is analyzed
+// again as part of a test package, is suppressed again.
+func (b *Baseline) Suppress(analyzer string, posn token.Position, message string) bool {
+	k := entryKey{analyzer, relativePath(b.dir, posn.Filename), message}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	count := b.counts[k]
+	if count == 0 {
+		return false
+	}
+
+	at := fmt.Sprintf("%d:%d", posn.Line, posn.Column)
+
+	positions := b.suppressed[k]
+	if positions[at] {
+		return true
+	}
+
+	if len(positions) >= count {
+		return false
+	}
+
+	if positions == nil {
+		positions = make(map[string]bool, count)
+		b.suppressed[k] = positions
+	}
+
+	positions[at] = true
+
+	return true
+}
+
+// Wrap returns a copy of an analyzer whose diagnostics are dropped if they
+// are recorded in the baseline.
+func (b *Baseline) Wrap(analyzer *analysis.Analyzer) *analysis.Analyzer {
+	run := analyzer.Run
+
+	wrapped := *analyzer
+	wrapped.Run = func(pass *analysis.Pass) (any, error) {
+		filtered := *pass
+		filtered.Report = func(d analysis.Diagnostic) {
+			if !b.Suppress(analyzer.Name, pass.Fset.Position(d.Pos), d.Message) {
+				pass.Report(d)
+			}
+		}
+
+		return run(&filtered)
+	}
+
+	return &wrapped
+}
+
+// baseDir returns the absolute directory containing a baseline file.
+func baseDir(filename string) (string, error) {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve baseline path: %w", err)
+	}
+
+	return filepath.Dir(abs), nil
+}
+
+// relativePath returns a file name relative to dir with forward slashes, or
+// the file name unchanged if it is not within dir.
+func relativePath(dir string, filename string) string {
+	rel, err := filepath.Rel(dir, filename)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(filename)
+	}
+
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,144 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pathrules enables or disables analyzers for directory trees, so
+// that a rule can be turned off for legacy code while the rest of a module
+// keeps it.
+package pathrules
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// rule holds the analyzer overrides for one directory tree.
+type rule struct {
+	dir       string
+	analyzers map[string]bool
+}
+
+// Rules is a set of per-directory analyzer overrides.
+type Rules struct {
+	rules []rule
+}
+
+// New creates rules from a map of path prefixes to analyzer overrides. Each
+// prefix is a directory, relative to the working directory unless absolute,
+// and applies to the files in it and in its subdirectories.
+func New(pathRules map[string]map[string]bool) (*Rules, error) {
+	rules := make([]rule, 0, len(pathRules))
+
+	for prefix, analyzers := range pathRules {
+		dir, err := filepath.Abs(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve path %q: %w", prefix, err)
+		}
+
+		rules = append(rules, rule{
+			dir:       dir,
+			analyzers: analyzers,
+		})
+	}
+
+	// Longer directories are more specific, so check them first.
+	sort.Slice(rules, func(i, j int) bool {
+		if len(rules[i].dir) != len(rules[j].dir) {
+			return len(rules[i].dir) > len(rules[j].dir)
+		}
+
+		return rules[i].dir < rules[j].dir
+	})
+
+	return &Rules{
+		rules: rules,
+	}, nil
+}
+
+// Analyzers returns the sorted names of the analyzers the rules refer to.
+func (r *Rules) Analyzers() []string {
+	seen := make(map[string]bool)
+	for _, rule := range r.rules {
+		for name := range rule.analyzers {
+			seen[name] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Enables checks if any rule enables the named analyzer.
+func (r *Rules) Enables(analyzer string) bool {
+	for _, rule := range r.rules {
+		if rule.analyzers[analyzer] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Enabled checks if an analyzer is enabled for the named file. The most
+// specific rule that mentions the analyzer decides; if none does, the
+// analyzer's enabled state is fallback.
+func (r *Rules) Enabled(analyzer string, filename string, fallback bool) bool {
+	for _, rule := range r.rules {
+		enabled, ok := rule.analyzers[analyzer]
+		if ok && inDir(rule.dir, filename) {
+			return enabled
+		}
+	}
+
+	return fallback
+}
+
+// Wrap returns a copy of an analyzer whose diagnostics are dropped in files
+// for which the rules disable it. If enabled is false the analyzer reports
+// only in files for which a rule enables it.
+func (r *Rules) Wrap(analyzer *analysis.Analyzer, enabled bool) *analysis.Analyzer {
+	run := analyzer.Run
+
+	wrapped := *analyzer
+	wrapped.Run = func(pass *analysis.Pass) (any, error) {
+		filtered := *pass
+		filtered.Report = func(d analysis.Diagnostic) {
+			if r.Enabled(analyzer.Name, pass.Fset.Position(d.Pos).Filename, enabled) {
+				pass.Report(d)
+			}
+		}
+
+		return run(&filtered)
+	}
+
+	return &wrapped
+}
+
+// inDir checks if a file is in a directory or one of its subdirectories.
+func inDir(dir string, filename string) bool {
+	rel, err := filepath.Rel(dir, filename)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
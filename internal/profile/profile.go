@@ -0,0 +1,120 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package profile records the wall time analyzers spend on each package, so
+// that slow analyzers can be identified.
+package profile
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Timing is the time an analyzer spent across the packages it analyzed.
+type Timing struct {
+	Analyzer string
+	Packages int
+	Total    time.Duration
+}
+
+// Profile aggregates the timings of wrapped analyzers. Analyzers may run
+// concurrently, so it is safe for concurrent use.
+type Profile struct {
+	timings map[string]*Timing
+	mu      sync.Mutex
+}
+
+// New creates an empty profile.
+func New() *Profile {
+	return &Profile{
+		timings: make(map[string]*Timing),
+	}
+}
+
+// Wrap returns a copy of an analyzer whose runs are timed in the profile.
+func (p *Profile) Wrap(analyzer *analysis.Analyzer) *analysis.Analyzer {
+	run := analyzer.Run
+
+	wrapped := *analyzer
+	wrapped.Run = func(pass *analysis.Pass) (any, error) {
+		start := time.Now()
+		defer func() {
+			p.addRun(analyzer.Name, time.Since(start))
+		}()
+
+		return run(pass)
+	}
+
+	return &wrapped
+}
+
+// addRun adds the time of one package to an analyzer's timing.
+func (p *Profile) addRun(analyzer string, elapsed time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	timing, ok := p.timings[analyzer]
+	if !ok {
+		timing = &Timing{Analyzer: analyzer}
+		p.timings[analyzer] = timing
+	}
+
+	timing.Packages++
+	timing.Total += elapsed
+}
+
+// Timings returns the timing of each analyzer that has run, slowest first.
+func (p *Profile) Timings() []Timing {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	timings := make([]Timing, 0, len(p.timings))
+	for _, timing := range p.timings {
+		timings = append(timings, *timing)
+	}
+
+	sort.Slice(timings, func(i, j int) bool {
+		if timings[i].Total != timings[j].Total {
+			return timings[i].Total > timings[j].Total
+		}
+
+		return timings[i].Analyzer < timings[j].Analyzer
+	})
+
+	return timings
+}
+
+// Write writes the timings as a table, slowest analyzer first.
+func (p *Profile) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ANALYZER\tPACKAGES\tTOTAL\tAVERAGE")
+
+	for _, timing := range p.Timings() {
+		average := timing.Total / time.Duration(timing.Packages)
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n",
+			timing.Analyzer, timing.Packages, timing.Total.Round(time.Microsecond), average.Round(time.Microsecond))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("failed to write profile: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package servicetype provides the heuristic shared by analyzers to identify service-like types.
+package servicetype
+
+import "strings"
+
+// suffixes are suffixes that identify service types.
+var suffixes = []string{
+	"Service",
+	"Manager",
+	"Handler",
+	"Controller",
+	"Provider",
+	"Client",
+	"Server",
+}
+
+// IsName checks if a type name looks like a service.
+func IsName(name string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
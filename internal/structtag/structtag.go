@@ -0,0 +1,97 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package structtag reads struct field tags from syntax.
+package structtag
+
+import (
+	"go/ast"
+	"reflect"
+	"strconv"
+)
+
+// Lookup returns the value of the tag key in a field's struct tag, and
+// whether the key is present.
+func Lookup(field *ast.Field, key string) (string, bool) {
+	if field.Tag == nil {
+		return "", false
+	}
+
+	tag, err := strconv.Unquote(field.Tag.Value)
+	if err != nil {
+		return "", false
+	}
+
+	return reflect.StructTag(tag).Lookup(key)
+}
+
+// Entry is a key and its quoted value in a struct tag.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// String returns the entry as it appears in a struct tag.
+func (e Entry) String() string {
+	return e.Key + ":" + e.Value
+}
+
+// Parse splits a struct tag, such as `json:"id" yaml:"id"`, into its
+// entries in order. Values keep their quotes. It returns false if the tag
+// does not follow the conventional key:"value" format.
+func Parse(tag string) ([]Entry, bool) {
+	var entries []Entry
+
+	for {
+		// Skip leading space.
+		i := 0
+		for i < len(tag) && tag[i] == ' ' {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			return entries, true
+		}
+
+		// Scan to colon. A space, a quote or a control character is a syntax error.
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 || i+1 >= len(tag) || tag[i] != ':' || tag[i+1] != '"' {
+			return nil, false
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// Scan quoted string to find value.
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, false
+		}
+		value := tag[:i+1]
+		tag = tag[i+1:]
+
+		if _, err := strconv.Unquote(value); err != nil {
+			return nil, false
+		}
+
+		entries = append(entries, Entry{Key: key, Value: value})
+	}
+}
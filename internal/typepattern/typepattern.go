@@ -0,0 +1,71 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package typepattern matches type names against configured patterns such as "zerolog.Logger".
+package typepattern
+
+import (
+	"go/types"
+	"strings"
+)
+
+// String returns a string representation of the type suitable for pattern matching.
+func String(t types.Type) string {
+	// Get the full type string including package path.
+	return types.TypeString(t, nil)
+}
+
+// Match checks if a type string matches a pattern.
+// Patterns can be:
+// - Exact match: "zerolog.Logger"
+// - Pointer: "*zerolog.Logger"
+// The type string from types.TypeString includes the full package path,
+// so we match against the suffix.
+func Match(typeName, pattern string) bool {
+	// Handle pointer patterns.
+	if strings.HasPrefix(pattern, "*") {
+		if !strings.HasPrefix(typeName, "*") {
+			return false
+		}
+		typeName = strings.TrimPrefix(typeName, "*")
+		pattern = strings.TrimPrefix(pattern, "*")
+	} else if strings.HasPrefix(typeName, "*") {
+		// Pattern is not pointer but type is.
+		return false
+	}
+
+	// Check if the type name ends with the pattern (handles full package paths).
+	// e.g., "github.com/rs/zerolog.Logger" ends with "zerolog.Logger"
+	if strings.HasSuffix(typeName, pattern) {
+		// Ensure we match at a package boundary.
+		prefix := strings.TrimSuffix(typeName, pattern)
+		if prefix == "" || strings.HasSuffix(prefix, "/") || strings.HasSuffix(prefix, ".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchAny checks if a type matches any of the patterns.
+func MatchAny(t types.Type, patterns []string) bool {
+	typeName := String(t)
+
+	for _, pattern := range patterns {
+		if Match(typeName, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
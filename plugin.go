@@ -16,16 +16,73 @@
 package attgolinter
 
 import (
+	"cmp"
 	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/attestantio/attgo-linter/analyzers/acronymcase"
+	"github.com/attestantio/attgo-linter/analyzers/assertlib"
 	"github.com/attestantio/attgo-linter/analyzers/capitalcomment"
+	"github.com/attestantio/attgo-linter/analyzers/closeonce"
+	"github.com/attestantio/attgo-linter/analyzers/ctxlifetime"
+	"github.com/attestantio/attgo-linter/analyzers/ctxvalue"
 	"github.com/attestantio/attgo-linter/analyzers/currentyear"
+	"github.com/attestantio/attgo-linter/analyzers/deadexport"
+	"github.com/attestantio/attgo-linter/analyzers/deferinloop"
+	"github.com/attestantio/attgo-linter/analyzers/domaintype"
+	"github.com/attestantio/attgo-linter/analyzers/duplicatelogger"
 	"github.com/attestantio/attgo-linter/analyzers/enumiota"
+	"github.com/attestantio/attgo-linter/analyzers/envread"
+	"github.com/attestantio/attgo-linter/analyzers/errstring"
+	"github.com/attestantio/attgo-linter/analyzers/floatstructcmp"
 	"github.com/attestantio/attgo-linter/analyzers/funcopts"
+	"github.com/attestantio/attgo-linter/analyzers/handlersig"
+	"github.com/attestantio/attgo-linter/analyzers/ignorederror"
 	"github.com/attestantio/attgo-linter/analyzers/interfacecheck"
+	"github.com/attestantio/attgo-linter/analyzers/interfaceplacement"
+	"github.com/attestantio/attgo-linter/analyzers/ioerror"
+	"github.com/attestantio/attgo-linter/analyzers/jsontagrequired"
+	"github.com/attestantio/attgo-linter/analyzers/lifecyclemethod"
+	"github.com/attestantio/attgo-linter/analyzers/lostcancel"
+	"github.com/attestantio/attgo-linter/analyzers/mapinit"
+	"github.com/attestantio/attgo-linter/analyzers/metricname"
+	"github.com/attestantio/attgo-linter/analyzers/metricstype"
+	"github.com/attestantio/attgo-linter/analyzers/nilguard"
+	"github.com/attestantio/attgo-linter/analyzers/nocontextbackground"
+	"github.com/attestantio/attgo-linter/analyzers/noemptyinterface"
+	"github.com/attestantio/attgo-linter/analyzers/nolockcopy"
+	"github.com/attestantio/attgo-linter/analyzers/nonnilslice"
 	"github.com/attestantio/attgo-linter/analyzers/nopkglogger"
+	"github.com/attestantio/attgo-linter/analyzers/paramcount"
 	"github.com/attestantio/attgo-linter/analyzers/rawstring"
+	"github.com/attestantio/attgo-linter/analyzers/readonlyreturn"
+	"github.com/attestantio/attgo-linter/analyzers/requirector"
+	"github.com/attestantio/attgo-linter/analyzers/retrybackoff"
+	"github.com/attestantio/attgo-linter/analyzers/saferoutine"
+	"github.com/attestantio/attgo-linter/analyzers/selectctx"
+	"github.com/attestantio/attgo-linter/analyzers/sharedmutable"
+	"github.com/attestantio/attgo-linter/analyzers/slicepointer"
+	"github.com/attestantio/attgo-linter/analyzers/staticerr"
 	"github.com/attestantio/attgo-linter/analyzers/structfieldorder"
+	"github.com/attestantio/attgo-linter/analyzers/tagorder"
+	"github.com/attestantio/attgo-linter/analyzers/testpkg"
+	"github.com/attestantio/attgo-linter/analyzers/timeequal"
+	"github.com/attestantio/attgo-linter/analyzers/unkeyedfields"
+	"github.com/attestantio/attgo-linter/analyzers/varconst"
+	"github.com/attestantio/attgo-linter/analyzers/wgadd"
+	"github.com/attestantio/attgo-linter/analyzers/wrapexternal"
+	"github.com/attestantio/attgo-linter/internal/baseline"
+	"github.com/attestantio/attgo-linter/internal/pathrules"
+	"github.com/attestantio/attgo-linter/internal/profile"
 	"github.com/golangci/plugin-module-register/register"
 	"golang.org/x/tools/go/analysis"
 )
@@ -34,9 +91,20 @@ func init() {
 	register.Plugin("attgo", New)
 }
 
+// profileWriteInterval is the minimum time between writes of the profile
+// file while analyzers run.
+const profileWriteInterval = time.Second
+
 // Plugin implements the golangci-lint module plugin interface.
 type Plugin struct {
-	cfg *Config
+	cfg     *Config
+	profile *profile.Profile
+	// profileWritten is when the profile file was last written by a run.
+	profileWritten time.Time
+	// profilePending is set while a write of the profile file is scheduled.
+	profilePending bool
+	profileMu      sync.Mutex
+	profileFileMu  sync.Mutex
 }
 
 // New creates a new attgo linter plugin with the given settings.
@@ -47,12 +115,12 @@ func New(settings any) (register.LinterPlugin, error) {
 		// Settings come as map[string]any, marshal/unmarshal to apply.
 		data, err := json.Marshal(settings)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to marshal settings: %w", err)
 		}
 
 		var userCfg Config
 		if err := json.Unmarshal(data, &userCfg); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to parse settings: %w", err)
 		}
 
 		// Apply user configuration.
@@ -60,50 +128,177 @@ func New(settings any) (register.LinterPlugin, error) {
 		// Re-unmarshal to check which fields were explicitly set.
 		var rawSettings map[string]any
 		if err := json.Unmarshal(data, &rawSettings); err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to parse settings: %w", err)
 		}
 
-		if _, ok := rawSettings["enable_no_pkg_logger"]; ok {
-			cfg.EnableNoPkgLogger = userCfg.EnableNoPkgLogger
+		userFlags := userCfg.enableFlags()
+		for key, flag := range cfg.enableFlags() {
+			if _, ok := rawSettings[key]; ok {
+				*flag = *userFlags[key]
+			}
+		}
+
+		// Analyzer-specific boolean settings.
+		if _, ok := rawSettings["capital_comment_require_period"]; ok {
+			cfg.CapitalCommentRequirePeriod = userCfg.CapitalCommentRequirePeriod
+		}
+		if _, ok := rawSettings["capital_comment_check_scope"]; ok {
+			cfg.CapitalCommentCheckScope = userCfg.CapitalCommentCheckScope
+		}
+		if _, ok := rawSettings["capital_comment_no_all_caps"]; ok {
+			cfg.CapitalCommentNoAllCaps = userCfg.CapitalCommentNoAllCaps
+		}
+		if _, ok := rawSettings["capital_comment_skip_paths_and_flags"]; ok {
+			cfg.CapitalCommentSkipPathsAndFlags = userCfg.CapitalCommentSkipPathsAndFlags
+		}
+		if _, ok := rawSettings["enum_iota_safe_string"]; ok {
+			cfg.EnumIotaSafeString = userCfg.EnumIotaSafeString
+		}
+		if _, ok := rawSettings["enum_iota_single_block"]; ok {
+			cfg.EnumIotaSingleBlock = userCfg.EnumIotaSingleBlock
+		}
+		if _, ok := rawSettings["enum_iota_require_unknown"]; ok {
+			cfg.EnumIotaRequireUnknown = userCfg.EnumIotaRequireUnknown
+		}
+		if _, ok := rawSettings["enum_iota_unsigned_bitmask"]; ok {
+			cfg.EnumIotaUnsignedBitmask = userCfg.EnumIotaUnsignedBitmask
+		}
+		if _, ok := rawSettings["func_opts_allow_builders"]; ok {
+			cfg.FuncOptsAllowBuilders = userCfg.FuncOptsAllowBuilders
 		}
-		if _, ok := rawSettings["enable_enum_iota"]; ok {
-			cfg.EnableEnumIota = userCfg.EnableEnumIota
+		if _, ok := rawSettings["raw_string_summary_only"]; ok {
+			cfg.RawStringSummaryOnly = userCfg.RawStringSummaryOnly
 		}
-		if _, ok := rawSettings["enable_current_year"]; ok {
-			cfg.EnableCurrentYear = userCfg.EnableCurrentYear
+		if _, ok := rawSettings["raw_string_report_unneeded"]; ok {
+			cfg.RawStringReportUnneeded = userCfg.RawStringReportUnneeded
 		}
-		if _, ok := rawSettings["enable_capital_comment"]; ok {
-			cfg.EnableCapitalComment = userCfg.EnableCapitalComment
+		if _, ok := rawSettings["no_context_background_allow_main_init"]; ok {
+			cfg.NoContextBackgroundAllowMainInit = userCfg.NoContextBackgroundAllowMainInit
 		}
-		if _, ok := rawSettings["enable_func_opts"]; ok {
-			cfg.EnableFuncOpts = userCfg.EnableFuncOpts
+		if _, ok := rawSettings["require_ctor_services_only"]; ok {
+			cfg.RequireCtorServicesOnly = userCfg.RequireCtorServicesOnly
 		}
-		if _, ok := rawSettings["enable_raw_string"]; ok {
-			cfg.EnableRawString = userCfg.EnableRawString
+		if _, ok := rawSettings["struct_field_order_consolidate"]; ok {
+			cfg.StructFieldOrderConsolidate = userCfg.StructFieldOrderConsolidate
 		}
-		if _, ok := rawSettings["enable_struct_field_order"]; ok {
-			cfg.EnableStructFieldOrder = userCfg.EnableStructFieldOrder
+		if _, ok := rawSettings["struct_field_order_deps_as_interfaces"]; ok {
+			cfg.StructFieldOrderDepsAsInterfaces = userCfg.StructFieldOrderDepsAsInterfaces
 		}
-		if _, ok := rawSettings["enable_interface_check"]; ok {
-			cfg.EnableInterfaceCheck = userCfg.EnableInterfaceCheck
+		if _, ok := rawSettings["struct_field_order_exported_first"]; ok {
+			cfg.StructFieldOrderExportedFirst = userCfg.StructFieldOrderExportedFirst
+		}
+		if _, ok := rawSettings["struct_field_order_optimize_padding"]; ok {
+			cfg.StructFieldOrderOptimizePadding = userCfg.StructFieldOrderOptimizePadding
+		}
+
+		if _, ok := rawSettings["profile_analyzers"]; ok {
+			cfg.ProfileAnalyzers = userCfg.ProfileAnalyzers
 		}
 
 		cfg.Merge(&userCfg)
 	}
 
-	return &Plugin{cfg: cfg}, nil
+	if err := applyEnvOverrides(cfg); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	plugin := &Plugin{cfg: cfg}
+	if cfg.ProfileAnalyzers {
+		plugin.profile = profile.New()
+	}
+
+	return plugin, nil
+}
+
+// envPrefix is prepended to the upper-cased settings name of an enable flag
+// to give the environment variable that overrides it, for example
+// ATTGO_ENABLE_RAW_STRING for enable_raw_string.
+const envPrefix = "ATTGO_"
+
+// applyEnvOverrides sets enable flags from environment variables.
+// Environment variables take precedence over the settings file, allowing CI
+// to switch individual analyzers on or off without editing configuration.
+func applyEnvOverrides(cfg *Config) error {
+	for key, flag := range cfg.enableFlags() {
+		name := envPrefix + strings.ToUpper(key)
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for %s; must be true or false", value, name)
+		}
+
+		*flag = enabled
+	}
+
+	return nil
 }
 
 // BuildAnalyzers returns the analyzers to run based on configuration.
+// Analyzers are returned in a fixed order, HIGH priority first and LOW
+// priority last, except that those named in AnalyzerOrder come first in
+// the order given.
 func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	analyzers, err := p.buildEnabled()
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := p.pathRules()
+	if err != nil {
+		return nil, err
+	}
+
+	if rules != nil {
+		analyzers, err = p.applyPathRules(rules, analyzers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.cfg.AnalyzerOrder) > 0 {
+		analyzers, err = p.applyOrder(analyzers)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	analyzers, err = p.applyBaseline(analyzers)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.applyProfile(analyzers), nil
+}
+
+// buildEnabled returns the enabled analyzers in their default order.
+func (p *Plugin) buildEnabled() ([]*analysis.Analyzer, error) {
 	var analyzers []*analysis.Analyzer
 
 	// HIGH PRIORITY (enabled by default)
 	if p.cfg.EnableNoPkgLogger {
-		analyzers = append(analyzers, nopkglogger.NewAnalyzer(p.cfg.LoggerTypePatterns))
+		analyzers = append(analyzers, nopkglogger.NewAnalyzer(
+			p.cfg.LoggerTypePatterns,
+			nopkglogger.WithNopConstructors(p.cfg.NoPkgLoggerNopConstructors),
+		))
 	}
 	if p.cfg.EnableEnumIota {
-		analyzers = append(analyzers, enumiota.NewAnalyzer(p.cfg.EnumTypeSuffixes))
+		analyzers = append(analyzers, enumiota.NewAnalyzer(
+			p.cfg.EnumTypeSuffixes,
+			enumiota.WithSafeString(p.cfg.EnumIotaSafeString),
+			enumiota.WithSingleBlock(p.cfg.EnumIotaSingleBlock),
+			enumiota.WithRequireUnknown(p.cfg.EnumIotaRequireUnknown),
+			enumiota.WithUnsignedBitmask(p.cfg.EnumIotaUnsignedBitmask),
+			enumiota.WithExcludeTypes(p.cfg.EnumIotaExcludeTypes),
+		))
 	}
 	if p.cfg.EnableCurrentYear {
 		analyzers = append(analyzers, currentyear.Analyzer)
@@ -111,26 +306,502 @@ func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 
 	// MEDIUM PRIORITY (disabled by default)
 	if p.cfg.EnableCapitalComment {
-		analyzers = append(analyzers, capitalcomment.Analyzer)
+		mode, err := capitalcomment.ParseMode(p.cfg.CapitalCommentMode)
+		if err != nil {
+			return nil, fmt.Errorf("invalid capital_comment_mode: %w", err)
+		}
+		analyzers = append(analyzers, capitalcomment.NewAnalyzer(
+			capitalcomment.WithMode(mode),
+			capitalcomment.WithRequirePeriod(p.cfg.CapitalCommentRequirePeriod),
+			capitalcomment.WithCheckScope(p.cfg.CapitalCommentCheckScope),
+			capitalcomment.WithLowercaseGlossary(p.cfg.CapitalCommentLowercaseGlossary),
+			capitalcomment.WithNoAllCaps(p.cfg.CapitalCommentNoAllCaps),
+			capitalcomment.WithAcronyms(p.cfg.AcronymCaseInitialisms),
+			capitalcomment.WithSkipPathsAndFlags(p.cfg.CapitalCommentSkipPathsAndFlags),
+		))
 	}
 	if p.cfg.EnableFuncOpts {
-		analyzers = append(analyzers, funcopts.Analyzer)
+		analyzers = append(analyzers, funcopts.NewAnalyzer(
+			funcopts.WithAllowBuilders(p.cfg.FuncOptsAllowBuilders),
+		))
 	}
 	if p.cfg.EnableRawString {
-		analyzers = append(analyzers, rawstring.Analyzer)
+		analyzers = append(analyzers, rawstring.NewAnalyzer(
+			rawstring.WithSummaryOnly(p.cfg.RawStringSummaryOnly),
+			rawstring.WithReportUnneeded(p.cfg.RawStringReportUnneeded),
+			rawstring.WithSkipFilePatterns(p.cfg.RawStringSkipFilePatterns),
+			rawstring.WithMinEscapeRatio(p.cfg.RawStringMinEscapeRatio),
+		))
+	}
+	if p.cfg.EnableStaticErr {
+		analyzers = append(analyzers, staticerr.Analyzer)
+	}
+	if p.cfg.EnableUnkeyedFields {
+		analyzers = append(analyzers, unkeyedfields.NewAnalyzer(p.cfg.UnkeyedFieldsThreshold, p.cfg.UnkeyedFieldsIgnorePackages))
+	}
+	if p.cfg.EnableNoContextBackground {
+		analyzers = append(analyzers, nocontextbackground.NewAnalyzer(p.cfg.NoContextBackgroundAllowMainInit))
+	}
+	if p.cfg.EnableMapInit {
+		mode, err := mapinit.ParseMode(p.cfg.MapInitMode)
+		if err != nil {
+			return nil, fmt.Errorf("invalid map_init_mode: %w", err)
+		}
+		analyzers = append(analyzers, mapinit.NewAnalyzer(mode))
+	}
+	if p.cfg.EnableSafeRoutine {
+		analyzers = append(analyzers, saferoutine.NewAnalyzer(p.cfg.SafeRoutineLauncher))
+	}
+	if p.cfg.EnableAcronymCase {
+		analyzers = append(analyzers, acronymcase.NewAnalyzer(p.cfg.AcronymCaseInitialisms))
+	}
+	if p.cfg.EnableSelectCtx {
+		analyzers = append(analyzers, selectctx.Analyzer)
+	}
+	if p.cfg.EnableErrString {
+		analyzers = append(analyzers, errstring.Analyzer)
+	}
+	if p.cfg.EnableRequireCtor {
+		analyzers = append(analyzers, requirector.NewAnalyzer(p.cfg.RequireCtorServicesOnly))
+	}
+	if p.cfg.EnableNoEmptyInterface {
+		analyzers = append(analyzers, noemptyinterface.Analyzer)
+	}
+	if p.cfg.EnableMetricsType {
+		analyzers = append(analyzers, metricstype.NewAnalyzer(p.cfg.MetricsTypePatterns))
+	}
+	if p.cfg.EnableTestPkg {
+		analyzers = append(analyzers, testpkg.Analyzer)
+	}
+	if p.cfg.EnableWrapExternal {
+		analyzers = append(analyzers, wrapexternal.Analyzer)
+	}
+	if p.cfg.EnableJSONTagRequired {
+		analyzers = append(analyzers, jsontagrequired.Analyzer)
+	}
+	if p.cfg.EnableHandlerSig {
+		analyzers = append(analyzers, handlersig.Analyzer)
+	}
+	if p.cfg.EnableCtxValue {
+		analyzers = append(analyzers, ctxvalue.Analyzer)
+	}
+	if p.cfg.EnableRetryBackoff {
+		analyzers = append(analyzers, retrybackoff.NewAnalyzer(p.cfg.RetryBackoffHelper))
+	}
+	if p.cfg.EnableDeadExport {
+		analyzers = append(analyzers, deadexport.Analyzer)
+	}
+	if p.cfg.EnableLifecycleMethod {
+		analyzers = append(analyzers, lifecyclemethod.Analyzer)
+	}
+	if p.cfg.EnableVarConst {
+		analyzers = append(analyzers, varconst.Analyzer)
+	}
+	if p.cfg.EnableMetricName {
+		analyzers = append(analyzers, metricname.Analyzer)
+	}
+	if p.cfg.EnableEnvRead {
+		analyzers = append(analyzers, envread.NewAnalyzer(p.cfg.EnvReadAllowedPackages))
+	}
+	if p.cfg.EnableIOError {
+		analyzers = append(analyzers, ioerror.NewAnalyzer(p.cfg.IOErrorFunctions))
+	}
+	if p.cfg.EnableSharedMutable {
+		analyzers = append(analyzers, sharedmutable.Analyzer)
+	}
+	if p.cfg.EnableWgAdd {
+		analyzers = append(analyzers, wgadd.Analyzer)
+	}
+	if p.cfg.EnableInterfacePlacement {
+		analyzers = append(analyzers, interfaceplacement.Analyzer)
+	}
+	if p.cfg.EnableTimeEqual {
+		analyzers = append(analyzers, timeequal.Analyzer)
+	}
+	if p.cfg.EnableParamCount {
+		analyzers = append(analyzers, paramcount.NewAnalyzer(p.cfg.ParamCountMax))
+	}
+	if p.cfg.EnableDomainType {
+		analyzers = append(analyzers, domaintype.NewAnalyzer(p.cfg.DomainTypeSuffixes))
+	}
+	if p.cfg.EnableIgnoredError {
+		analyzers = append(analyzers, ignorederror.Analyzer)
+	}
+	if p.cfg.EnableDuplicateLogger {
+		analyzers = append(analyzers, duplicatelogger.NewAnalyzer(p.cfg.LoggerTypePatterns))
+	}
+	if p.cfg.EnableReadonlyReturn {
+		analyzers = append(analyzers, readonlyreturn.Analyzer)
+	}
+	if p.cfg.EnableAssertLib {
+		analyzers = append(analyzers, assertlib.NewAnalyzer(p.cfg.AssertLibName))
+	}
+	if p.cfg.EnableFloatStructCmp {
+		analyzers = append(analyzers, floatstructcmp.Analyzer)
+	}
+	if p.cfg.EnableNilGuard {
+		analyzers = append(analyzers, nilguard.Analyzer)
+	}
+	if p.cfg.EnableCtxLifetime {
+		analyzers = append(analyzers, ctxlifetime.Analyzer)
+	}
+	if p.cfg.EnableDeferInLoop {
+		analyzers = append(analyzers, deferinloop.Analyzer)
+	}
+	if p.cfg.EnableNoLockCopy {
+		analyzers = append(analyzers, nolockcopy.Analyzer)
+	}
+	if p.cfg.EnableLostCancel {
+		analyzers = append(analyzers, lostcancel.Analyzer)
+	}
+	if p.cfg.EnableSlicePointer {
+		analyzers = append(analyzers, slicepointer.Analyzer)
 	}
 
 	// LOW PRIORITY (disabled by default)
 	if p.cfg.EnableStructFieldOrder {
-		analyzers = append(analyzers, structfieldorder.Analyzer)
+		analyzers = append(analyzers, structfieldorder.NewAnalyzer(
+			structfieldorder.WithConsolidate(p.cfg.StructFieldOrderConsolidate),
+			structfieldorder.WithGroupTag(p.cfg.StructFieldOrderGroupTag),
+			structfieldorder.WithDepsAsInterfaces(p.cfg.StructFieldOrderDepsAsInterfaces),
+			structfieldorder.WithExportedFirst(p.cfg.StructFieldOrderExportedFirst),
+			structfieldorder.WithOptimizePadding(p.cfg.StructFieldOrderOptimizePadding),
+		))
 	}
 	if p.cfg.EnableInterfaceCheck {
-		analyzers = append(analyzers, interfacecheck.Analyzer)
+		analyzers = append(analyzers, interfacecheck.NewAnalyzer(
+			interfacecheck.WithLocalModulePrefix(p.cfg.LocalModulePrefix),
+			interfacecheck.WithTargetFile(p.cfg.InterfaceCheckTargetFile),
+		))
+	}
+	if p.cfg.EnableCloseOnce {
+		analyzers = append(analyzers, closeonce.Analyzer)
+	}
+	if p.cfg.EnableTagOrder {
+		analyzers = append(analyzers, tagorder.NewAnalyzer(p.cfg.TagOrder))
+	}
+	if p.cfg.EnableNonNilSlice {
+		analyzers = append(analyzers, nonnilslice.Analyzer)
 	}
 
 	return analyzers, nil
 }
 
+// BuildAnalyzersFor returns only the named analyzers, such as
+// "attgo_raw_string", in the order given. The analyzers are built from the
+// plugin's settings, but are returned whether or not they are enabled,
+// which is useful when debugging a single rule.
+func (p *Plugin) BuildAnalyzersFor(names ...string) ([]*analysis.Analyzer, error) {
+	all, err := p.buildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*analysis.Analyzer, len(all))
+	for _, analyzer := range all {
+		byName[analyzer.Name] = analyzer
+	}
+
+	analyzers := make([]*analysis.Analyzer, 0, len(names))
+
+	for _, name := range names {
+		analyzer, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown analyzer %q", name)
+		}
+
+		analyzers = append(analyzers, analyzer)
+	}
+
+	rules, err := p.pathRules()
+	if err != nil {
+		return nil, err
+	}
+
+	if rules != nil {
+		// Named analyzers are enabled, except where a path rule disables them.
+		for i, analyzer := range analyzers {
+			analyzers[i] = rules.Wrap(analyzer, true)
+		}
+	}
+
+	analyzers, err = p.applyBaseline(analyzers)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.applyProfile(analyzers), nil
+}
+
+// buildAll returns every analyzer, built from the plugin's settings but
+// regardless of whether it is enabled, in the default order.
+func (p *Plugin) buildAll() ([]*analysis.Analyzer, error) {
+	cfg := *p.cfg
+	for _, flag := range cfg.enableFlags() {
+		*flag = true
+	}
+
+	return (&Plugin{cfg: &cfg}).buildEnabled()
+}
+
+// applyOrder moves the analyzers named in AnalyzerOrder to the front, in
+// the order given. The other analyzers keep their relative order. Names of
+// analyzers that are not enabled are ignored, but unknown names are an error.
+func (p *Plugin) applyOrder(analyzers []*analysis.Analyzer) ([]*analysis.Analyzer, error) {
+	all, err := p.buildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(all))
+	for _, analyzer := range all {
+		known[analyzer.Name] = true
+	}
+
+	rank := make(map[string]int, len(p.cfg.AnalyzerOrder))
+
+	for i, name := range p.cfg.AnalyzerOrder {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown analyzer %q in analyzer_order", name)
+		}
+
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+
+	ordered := slices.Clone(analyzers)
+	slices.SortStableFunc(ordered, func(a, b *analysis.Analyzer) int {
+		rankA, okA := rank[a.Name]
+		rankB, okB := rank[b.Name]
+
+		switch {
+		case okA && okB:
+			return cmp.Compare(rankA, rankB)
+		case okA:
+			return -1
+		case okB:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return ordered, nil
+}
+
+// pathRules returns the configured path rules, or nil if there are none.
+// Unknown analyzer names are an error.
+func (p *Plugin) pathRules() (*pathrules.Rules, error) {
+	if len(p.cfg.PathRules) == 0 {
+		return nil, nil
+	}
+
+	rules, err := pathrules.New(p.cfg.PathRules)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path_rules: %w", err)
+	}
+
+	all, err := p.buildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(all))
+	for _, analyzer := range all {
+		known[analyzer.Name] = true
+	}
+
+	for _, name := range rules.Analyzers() {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown analyzer %q in path_rules", name)
+		}
+	}
+
+	return rules, nil
+}
+
+// applyPathRules wraps the enabled analyzers so that path rules can disable
+// them for directory trees, and adds disabled analyzers that a path rule
+// enables, reporting only in the trees where they are enabled. Analyzers
+// keep their default order.
+func (p *Plugin) applyPathRules(rules *pathrules.Rules, analyzers []*analysis.Analyzer) ([]*analysis.Analyzer, error) {
+	all, err := p.buildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[string]*analysis.Analyzer, len(analyzers))
+	for _, analyzer := range analyzers {
+		enabled[analyzer.Name] = analyzer
+	}
+
+	wrapped := make([]*analysis.Analyzer, 0, len(analyzers))
+	for _, analyzer := range all {
+		if enabledAnalyzer, ok := enabled[analyzer.Name]; ok {
+			wrapped = append(wrapped, rules.Wrap(enabledAnalyzer, true))
+		} else if rules.Enables(analyzer.Name) {
+			wrapped = append(wrapped, rules.Wrap(analyzer, false))
+		}
+	}
+
+	return wrapped, nil
+}
+
+// applyBaseline wraps the analyzers so that findings recorded in the
+// baseline file, if one is configured, are not reported.
+func (p *Plugin) applyBaseline(analyzers []*analysis.Analyzer) ([]*analysis.Analyzer, error) {
+	if p.cfg.BaselineFile == "" {
+		return analyzers, nil
+	}
+
+	b, err := baseline.New(p.cfg.BaselineFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid baseline_file: %w", err)
+	}
+
+	wrapped := make([]*analysis.Analyzer, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		wrapped = append(wrapped, b.Wrap(analyzer))
+	}
+
+	return wrapped, nil
+}
+
+// applyProfile wraps the analyzers so that their runs are timed, if
+// profiling is enabled. If a profile file is set, each run schedules it to
+// be rewritten, so that it holds the totals when the process exits even if,
+// as under golangci-lint, WriteProfile is never called.
+func (p *Plugin) applyProfile(analyzers []*analysis.Analyzer) []*analysis.Analyzer {
+	if p.profile == nil {
+		return analyzers
+	}
+
+	wrapped := make([]*analysis.Analyzer, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		timed := p.profile.Wrap(analyzer)
+		if p.cfg.ProfileFile != "" {
+			run := timed.Run
+			timed.Run = func(pass *analysis.Pass) (any, error) {
+				result, err := run(pass)
+				p.scheduleProfileWrite()
+
+				return result, err
+			}
+		}
+
+		wrapped = append(wrapped, timed)
+	}
+
+	return wrapped
+}
+
+// WriteProfile writes the time each analyzer has spent so far, slowest
+// first, to the configured profile file, or to w if none is set. It does
+// nothing unless profiling is enabled.
+func (p *Plugin) WriteProfile(w io.Writer) error {
+	if p.profile == nil {
+		return nil
+	}
+
+	if p.cfg.ProfileFile == "" {
+		return p.profile.Write(w)
+	}
+
+	return p.writeProfileFile()
+}
+
+// scheduleProfileWrite rewrites the profile file in the background, at most
+// once per profileWriteInterval, so that runs neither wait for the write nor
+// fail with it. Failed writes are logged.
+func (p *Plugin) scheduleProfileWrite() {
+	p.profileMu.Lock()
+	defer p.profileMu.Unlock()
+
+	if p.profilePending {
+		return
+	}
+
+	p.profilePending = true
+
+	time.AfterFunc(profileWriteInterval-time.Since(p.profileWritten), func() {
+		p.profileMu.Lock()
+		p.profilePending = false
+		p.profileWritten = time.Now()
+		p.profileMu.Unlock()
+
+		if err := p.writeProfileFile(); err != nil {
+			log.Printf("attgo: %v", err)
+		}
+	})
+}
+
+// writeProfileFile replaces the contents of the profile file with the
+// timings so far.
+func (p *Plugin) writeProfileFile() error {
+	p.profileFileMu.Lock()
+	defer p.profileFileMu.Unlock()
+
+	f, err := os.Create(p.cfg.ProfileFile)
+	if err != nil {
+		return fmt.Errorf("failed to create profile file: %w", err)
+	}
+
+	if err := p.profile.Write(f); err != nil {
+		// Ignore error because the write error is reported instead.
+		_ = f.Close()
+
+		return fmt.Errorf("failed to write profile file: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close profile file: %w", err)
+	}
+
+	return nil
+}
+
+// Schema returns the plugin's settings keyed by name, with the JSON schema
+// type of each: "boolean", "integer", "number", "string", "array" or
+// "object". It is generated from the json tags of Config, so that editors
+// and golangci-lint can validate settings.
+func (p *Plugin) Schema() map[string]string {
+	configType := reflect.TypeFor[Config]()
+	schema := make(map[string]string, configType.NumField())
+
+	for i := range configType.NumField() {
+		field := configType.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		schema[name] = schemaType(field.Type)
+	}
+
+	return schema
+}
+
+// schemaType returns the JSON schema type of a settings field.
+func schemaType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
 // GetLoadMode returns the load mode required by the plugin.
 // LoadModeTypesInfo is needed for type-aware analysis (logger detection, enum types).
 func (p *Plugin) GetLoadMode() string {
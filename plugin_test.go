@@ -0,0 +1,507 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attgolinter_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	attgolinter "github.com/attestantio/attgo-linter"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// buildAnalyzer creates the plugin with the given settings and returns the named analyzer.
+func buildAnalyzer(t *testing.T, settings map[string]any, name string) *analysis.Analyzer {
+	t.Helper()
+
+	plugin, err := attgolinter.New(settings)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	analyzers, err := plugin.BuildAnalyzers()
+	if err != nil {
+		t.Fatalf("failed to build analyzers: %v", err)
+	}
+
+	for _, analyzer := range analyzers {
+		if analyzer.Name == name {
+			return analyzer
+		}
+	}
+
+	t.Fatalf("analyzer %s not built", name)
+
+	return nil
+}
+
+func TestLocalModulePrefix(t *testing.T) {
+	analyzer := buildAnalyzer(t, map[string]any{
+		"enable_interface_check": true,
+		"local_module_prefix":    "example.com/org",
+	}, "attgo_interface_check")
+
+	analysistest.Run(t, analysistest.TestData(), analyzer, "localprefix")
+}
+
+// analyzerNames creates the plugin with the given settings and returns the names of the built analyzers.
+func analyzerNames(t *testing.T, settings map[string]any) []string {
+	t.Helper()
+
+	plugin, err := attgolinter.New(settings)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	analyzers, err := plugin.BuildAnalyzers()
+	if err != nil {
+		t.Fatalf("failed to build analyzers: %v", err)
+	}
+
+	names := make([]string, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		names = append(names, analyzer.Name)
+	}
+
+	return names
+}
+
+func TestEnvOverrides(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		settings map[string]any
+		enabled  []string
+		disabled []string
+	}{
+		{
+			name:     "DisableOverridesFile",
+			env:      map[string]string{"ATTGO_ENABLE_RAW_STRING": "false"},
+			settings: map[string]any{"enable_raw_string": true},
+			disabled: []string{"attgo_raw_string"},
+		},
+		{
+			name:     "DisableDefault",
+			env:      map[string]string{"ATTGO_ENABLE_ENUM_IOTA": "0"},
+			enabled:  []string{"attgo_no_pkg_logger", "attgo_current_year"},
+			disabled: []string{"attgo_enum_iota"},
+		},
+		{
+			name:     "EnableWithoutSettings",
+			env:      map[string]string{"ATTGO_ENABLE_SELECT_CTX": "true"},
+			enabled:  []string{"attgo_select_ctx"},
+			disabled: []string{"attgo_raw_string"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			names := analyzerNames(t, test.settings)
+			for _, name := range test.enabled {
+				if !slices.Contains(names, name) {
+					t.Errorf("expected %s to be enabled, got %v", name, names)
+				}
+			}
+			for _, name := range test.disabled {
+				if slices.Contains(names, name) {
+					t.Errorf("expected %s to be disabled, got %v", name, names)
+				}
+			}
+		})
+	}
+}
+
+func TestEnvOverridesInvalid(t *testing.T) {
+	t.Setenv("ATTGO_ENABLE_RAW_STRING", "maybe")
+
+	if _, err := attgolinter.New(nil); err == nil {
+		t.Fatal("expected error for invalid environment value")
+	}
+}
+
+func TestNewValidates(t *testing.T) {
+	_, err := attgolinter.New(map[string]any{
+		"enable_map_init": true,
+		"map_init_mode":   "eager",
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid map_init_mode")
+	}
+}
+
+func TestBuildAnalyzersFor(t *testing.T) {
+	plugin, err := attgolinter.New(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	// Disabled analyzers can be requested by name.
+	analyzers, err := plugin.(*attgolinter.Plugin).BuildAnalyzersFor("attgo_raw_string")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(analyzers) != 1 || analyzers[0].Name != "attgo_raw_string" {
+		t.Errorf("expected only attgo_raw_string, got %v", analyzers)
+	}
+
+	_, err = plugin.(*attgolinter.Plugin).BuildAnalyzersFor("attgo_raw_string", "attgo_unknown")
+	if err == nil || !strings.Contains(err.Error(), `unknown analyzer "attgo_unknown"`) {
+		t.Errorf("expected unknown analyzer error, got %v", err)
+	}
+}
+
+func TestAnalyzerOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]any
+		expected []string
+	}{
+		{
+			name:     "Default",
+			expected: []string{"attgo_no_pkg_logger", "attgo_enum_iota", "attgo_current_year"},
+		},
+		{
+			name: "Custom",
+			settings: map[string]any{
+				"enable_raw_string": true,
+				"analyzer_order":    []string{"attgo_raw_string", "attgo_close_once", "attgo_current_year"},
+			},
+			expected: []string{"attgo_raw_string", "attgo_current_year", "attgo_no_pkg_logger", "attgo_enum_iota"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			names := analyzerNames(t, test.settings)
+			if !slices.Equal(names, test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, names)
+			}
+
+			// Repeated builds return the same order.
+			if again := analyzerNames(t, test.settings); !slices.Equal(again, names) {
+				t.Errorf("order changed between builds: %v then %v", names, again)
+			}
+		})
+	}
+}
+
+func TestAnalyzerOrderUnknown(t *testing.T) {
+	plugin, err := attgolinter.New(map[string]any{
+		"analyzer_order": []string{"attgo_unknown"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	_, err = plugin.BuildAnalyzers()
+	if err == nil || !strings.Contains(err.Error(), `unknown analyzer "attgo_unknown" in analyzer_order`) {
+		t.Errorf("expected unknown analyzer error, got %v", err)
+	}
+}
+
+func TestBaseline(t *testing.T) {
+	analyzer := buildAnalyzer(t, map[string]any{
+		"enable_raw_string": true,
+		"baseline_file":     "testdata/baseline.json",
+	}, "attgo_raw_string")
+
+	analysistest.Run(t, analysistest.TestData(), analyzer, "baseline")
+}
+
+func TestBaselineMissing(t *testing.T) {
+	plugin, err := attgolinter.New(map[string]any{
+		"baseline_file": "testdata/missing.json",
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	if _, err := plugin.BuildAnalyzers(); err == nil || !strings.Contains(err.Error(), "invalid baseline_file") {
+		t.Errorf("expected baseline error, got %v", err)
+	}
+}
+
+func TestPathRulesDisable(t *testing.T) {
+	analyzer := buildAnalyzer(t, map[string]any{
+		"enable_capital_comment": true,
+		"path_rules": map[string]any{
+			"testdata/src/pathrules/comments/legacy": map[string]any{
+				"attgo_capital_comment": false,
+			},
+			"testdata/src/pathrules/comments/legacy/kept/": map[string]any{
+				"attgo_capital_comment": true,
+			},
+		},
+	}, "attgo_capital_comment")
+
+	analysistest.Run(t, analysistest.TestData(), analyzer, "pathrules/comments/...")
+}
+
+func TestPathRulesEnable(t *testing.T) {
+	analyzer := buildAnalyzer(t, map[string]any{
+		"path_rules": map[string]any{
+			"testdata/src/pathrules/times/legacy": map[string]any{
+				"attgo_time_equal": true,
+			},
+		},
+	}, "attgo_time_equal")
+
+	analysistest.Run(t, analysistest.TestData(), analyzer, "pathrules/times/...")
+}
+
+func TestPathRulesUnknown(t *testing.T) {
+	plugin, err := attgolinter.New(map[string]any{
+		"path_rules": map[string]any{
+			"internal": map[string]any{"attgo_unknown": false},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	_, err = plugin.BuildAnalyzers()
+	if err == nil || !strings.Contains(err.Error(), `unknown analyzer "attgo_unknown" in path_rules`) {
+		t.Errorf("expected unknown analyzer error, got %v", err)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	plugin, err := attgolinter.New(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	schema := plugin.(*attgolinter.Plugin).Schema()
+
+	configType := reflect.TypeFor[attgolinter.Config]()
+	for i := range configType.NumField() {
+		field := configType.Field(i)
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" {
+			continue
+		}
+
+		if _, ok := schema[name]; !ok {
+			t.Errorf("schema is missing %s (field %s)", name, field.Name)
+		}
+	}
+
+	expected := map[string]string{
+		"enable_raw_string":           "boolean",
+		"unkeyed_fields_threshold":    "integer",
+		"raw_string_min_escape_ratio": "number",
+		"map_init_mode":               "string",
+		"logger_type_patterns":        "array",
+		"path_rules":                  "object",
+	}
+	for name, typ := range expected {
+		if schema[name] != typ {
+			t.Errorf("%s: got type %q, want %q", name, schema[name], typ)
+		}
+	}
+}
+
+func TestRuleDocs(t *testing.T) {
+	docs, err := attgolinter.RuleDocs()
+	if err != nil {
+		t.Fatalf("failed to build rule docs: %v", err)
+	}
+
+	var names []string
+	for _, doc := range docs {
+		names = append(names, doc.Name)
+	}
+
+	if !slices.IsSorted(names) {
+		t.Errorf("rule docs are not sorted: %v", names)
+	}
+
+	// Every analyzer is documented once.
+	plugin, err := attgolinter.New(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	if _, err := plugin.(*attgolinter.Plugin).BuildAnalyzersFor(names...); err != nil {
+		t.Errorf("rule docs name unknown analyzers: %v", err)
+	}
+
+	schema := plugin.(*attgolinter.Plugin).Schema()
+
+	var enableKeys []string
+	for name := range schema {
+		if strings.HasPrefix(name, "enable_") {
+			enableKeys = append(enableKeys, name)
+		}
+	}
+
+	if len(docs) != len(enableKeys) {
+		t.Errorf("got %d rule docs, want one for each of %d enable settings", len(docs), len(enableKeys))
+	}
+
+	for _, doc := range docs {
+		if doc.Doc == "" {
+			t.Errorf("%s: no doc text", doc.Name)
+		}
+
+		if want := "enable_" + strings.TrimPrefix(doc.Name, "attgo_"); len(doc.Settings) == 0 || doc.Settings[0] != want {
+			t.Errorf("%s: got settings %v, want %s first", doc.Name, doc.Settings, want)
+		}
+
+		for _, setting := range doc.Settings {
+			if _, ok := schema[setting]; !ok {
+				t.Errorf("%s: unknown setting %s", doc.Name, setting)
+			}
+		}
+	}
+
+	expected := map[string]attgolinter.RuleDoc{
+		"attgo_no_pkg_logger": {
+			Priority:         attgolinter.PriorityHigh,
+			EnabledByDefault: true,
+			Settings:         []string{"enable_no_pkg_logger", "logger_type_patterns", "logger_type_patterns_append", "no_pkg_logger_nop_constructors"},
+		},
+		"attgo_raw_string": {
+			Priority: attgolinter.PriorityMedium,
+			Settings: []string{"enable_raw_string", "raw_string_min_escape_ratio", "raw_string_report_unneeded", "raw_string_skip_file_patterns", "raw_string_summary_only"},
+		},
+		"attgo_close_once": {
+			Priority: attgolinter.PriorityLow,
+			Settings: []string{"enable_close_once"},
+		},
+		"attgo_tag_order": {
+			Priority: attgolinter.PriorityLow,
+			Settings: []string{"enable_tag_order", "tag_order"},
+		},
+	}
+
+	for _, doc := range docs {
+		want, ok := expected[doc.Name]
+		if !ok {
+			continue
+		}
+
+		if doc.Priority != want.Priority || doc.EnabledByDefault != want.EnabledByDefault || !slices.Equal(doc.Settings, want.Settings) {
+			t.Errorf("%s: got %s, %t, %v, want %s, %t, %v", doc.Name,
+				doc.Priority, doc.EnabledByDefault, doc.Settings,
+				want.Priority, want.EnabledByDefault, want.Settings)
+		}
+	}
+
+	again, err := attgolinter.RuleDocs()
+	if err != nil {
+		t.Fatalf("failed to build rule docs: %v", err)
+	}
+
+	if !reflect.DeepEqual(docs, again) {
+		t.Errorf("rule docs changed between calls")
+	}
+}
+
+func TestProfileAnalyzers(t *testing.T) {
+	plugin, err := attgolinter.New(map[string]any{
+		"profile_analyzers": true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	analyzers, err := plugin.(*attgolinter.Plugin).BuildAnalyzersFor("attgo_err_string")
+	if err != nil {
+		t.Fatalf("failed to build analyzers: %v", err)
+	}
+
+	analysistest.Run(t, analysistest.TestData(), analyzers[0], "profile")
+
+	var out bytes.Buffer
+	if err := plugin.(*attgolinter.Plugin).WriteProfile(&out); err != nil {
+		t.Fatalf("failed to write profile: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got profile %q, want a header and one analyzer", out.String())
+	}
+
+	fields := strings.Fields(lines[1])
+	if len(fields) != 4 || fields[0] != "attgo_err_string" || fields[1] != "1" {
+		t.Errorf("got profile line %q, want attgo_err_string with 1 package", lines[1])
+	}
+}
+
+func TestProfileFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "profile.txt")
+
+	plugin, err := attgolinter.New(map[string]any{
+		"profile_analyzers": true,
+		"profile_file":      filename,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	analyzers, err := plugin.(*attgolinter.Plugin).BuildAnalyzersFor("attgo_err_string")
+	if err != nil {
+		t.Fatalf("failed to build analyzers: %v", err)
+	}
+
+	analysistest.Run(t, analysistest.TestData(), analyzers[0], "profile")
+
+	// The file is written in the background without WriteProfile, as under
+	// golangci-lint.
+	var data []byte
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if data, err = os.ReadFile(filename); err == nil && bytes.Count(data, []byte("\n")) >= 2 {
+			break
+		}
+	}
+
+	if err != nil {
+		t.Fatalf("failed to read profile file: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[1], "attgo_err_string ") {
+		t.Errorf("got profile %q, want a header and attgo_err_string", data)
+	}
+}
+
+func TestProfileAnalyzersDisabled(t *testing.T) {
+	plugin, err := attgolinter.New(nil)
+	if err != nil {
+		t.Fatalf("failed to create plugin: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := plugin.(*attgolinter.Plugin).WriteProfile(&out); err != nil {
+		t.Fatalf("failed to write profile: %v", err)
+	}
+
+	if out.Len() != 0 {
+		t.Errorf("expected no profile, got %q", out.String())
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attgolinter
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Rule priorities, as used in RuleDoc.
+const (
+	PriorityHigh   = "high"
+	PriorityMedium = "medium"
+	PriorityLow    = "low"
+)
+
+// RuleDoc describes a rule for generated documentation.
+type RuleDoc struct {
+	// Name is the analyzer name, such as "attgo_raw_string".
+	Name string `json:"name"`
+	// Doc is the analyzer's documentation: a one-line summary, then details.
+	Doc string `json:"doc"`
+	// Priority is PriorityHigh, PriorityMedium or PriorityLow.
+	Priority string `json:"priority"`
+	// EnabledByDefault is true if the rule runs without configuration.
+	EnabledByDefault bool `json:"enabled_by_default"`
+	// Settings lists the sorted names of the settings the rule reads,
+	// starting with its enable_* setting.
+	Settings []string `json:"settings"`
+}
+
+// lowPriorityRules are the rules of LOW priority. Rules enabled by default
+// are of HIGH priority, and all others of MEDIUM priority.
+var lowPriorityRules = map[string]bool{
+	"attgo_struct_field_order": true,
+	"attgo_interface_check":    true,
+	"attgo_close_once":         true,
+	"attgo_tag_order":          true,
+	"attgo_non_nil_slice":      true,
+}
+
+// sharedSettings lists the settings a rule reads beyond those named after it.
+var sharedSettings = map[string][]string{
+	"attgo_no_pkg_logger":    {"logger_type_patterns", "logger_type_patterns_append"},
+	"attgo_duplicate_logger": {"logger_type_patterns", "logger_type_patterns_append"},
+	"attgo_enum_iota":        {"enum_type_suffixes", "enum_type_suffixes_append"},
+	"attgo_capital_comment":  {"acronym_case_initialisms"},
+	"attgo_interface_check":  {"local_module_prefix"},
+	"attgo_tag_order":        {"tag_order"},
+}
+
+// RuleDocs returns a description of every rule, sorted by name, built from
+// the analyzers and the default configuration. It can be rendered as a
+// table or encoded as JSON to generate style documentation.
+func RuleDocs() ([]RuleDoc, error) {
+	cfg := DefaultConfig()
+
+	analyzers, err := (&Plugin{cfg: cfg}).buildAll()
+	if err != nil {
+		return nil, err
+	}
+
+	enableFlags := cfg.enableFlags()
+	settings := settingNames()
+
+	docs := make([]RuleDoc, 0, len(analyzers))
+	for _, analyzer := range analyzers {
+		ruleName := strings.TrimPrefix(analyzer.Name, "attgo_")
+		enableKey := "enable_" + ruleName
+
+		enabled := enableFlags[enableKey] != nil && *enableFlags[enableKey]
+
+		priority := PriorityMedium
+		switch {
+		case enabled:
+			priority = PriorityHigh
+		case lowPriorityRules[analyzer.Name]:
+			priority = PriorityLow
+		}
+
+		var ruleSettings []string
+		for _, name := range settings {
+			if strings.HasPrefix(name, ruleName+"_") {
+				ruleSettings = append(ruleSettings, name)
+			}
+		}
+
+		ruleSettings = append(ruleSettings, sharedSettings[analyzer.Name]...)
+		slices.Sort(ruleSettings)
+
+		docs = append(docs, RuleDoc{
+			Name:             analyzer.Name,
+			Doc:              analyzer.Doc,
+			Priority:         priority,
+			EnabledByDefault: enabled,
+			Settings:         append([]string{enableKey}, ruleSettings...),
+		})
+	}
+
+	slices.SortFunc(docs, func(a, b RuleDoc) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return docs, nil
+}
+
+// settingNames returns the names of all settings, from the json tags of Config.
+func settingNames() []string {
+	configType := reflect.TypeFor[Config]()
+
+	names := make([]string, 0, configType.NumField())
+	for i := range configType.NumField() {
+		name, _, _ := strings.Cut(configType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
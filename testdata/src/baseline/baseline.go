@@ -0,0 +1,13 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package baseline
+
+// Existing finding, recorded in the baseline, so not reported.
+var existing = "C:\\Users\\name\\file.txt"
+
+// New finding identical to the existing one, which the baseline records once.
+var duplicate = "C:\\Users\\name\\file.txt" // want `string has 3 escape sequences; consider using a raw string`
+
+// New finding, not in the baseline.
+var added = "C:\\Users\\name\\Documents\\file.txt" // want `string has 4 escape sequences; consider using a raw string`
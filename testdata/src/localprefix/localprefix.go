@@ -0,0 +1,18 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package localprefix
+
+import "example.com/org/api"
+
+var _ api.Provider = (*Provider)(nil)
+
+// Provider implements an interface from the configured local module.
+type Provider struct{}
+
+func (p *Provider) Provide() error { return nil }
+
+// Service implements an interface from the configured local module without a check.
+type Service struct{} // want `struct "Service" implements interface "api.Service"`
+
+func (s *Service) Name() string { return "service" }
@@ -0,0 +1,8 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package comments
+
+func run() {
+	// reported as the rule is enabled outside the legacy tree. // want `comment should start with a capital letter`
+}
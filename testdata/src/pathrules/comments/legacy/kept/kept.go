@@ -0,0 +1,8 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package kept
+
+func run() {
+	// reported as a more specific rule enables the check. // want `comment should start with a capital letter`
+}
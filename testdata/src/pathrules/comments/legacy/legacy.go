@@ -0,0 +1,8 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package legacy
+
+func run() {
+	// not reported in the legacy tree.
+}
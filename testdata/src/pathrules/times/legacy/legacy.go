@@ -0,0 +1,11 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package legacy
+
+import "time"
+
+// Same is checked in the legacy tree.
+func Same(a time.Time, b time.Time) bool {
+	return a == b // want `compare time.Time with .Equal, not ==`
+}
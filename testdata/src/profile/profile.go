@@ -0,0 +1,8 @@
+// Copyright © 2026 Attestant Limited.
+// Licensed under the Apache License, Version 2.0 (the "License");
+
+package profile
+
+import "errors"
+
+var errFailed = errors.New("Failed to load") // want `error strings should not be capitalized`